@@ -0,0 +1,190 @@
+// prctl — административная утилита для дежурных: работает напрямую с базой
+// через те же репозитории и сервисы, что и сервер, поэтому аудит, outbox и
+// история активности пишутся так же, как при вызовах API.
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/stats"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	auditRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/audit"
+	outboxRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/outbox"
+	prRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/pullrequest"
+	statsRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/stats"
+	teamRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/team"
+	userRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/user"
+	"avito-backend-intern-assignment/internal/pkg/config"
+	"avito-backend-intern-assignment/internal/pkg/tracing"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/pgxadapter"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const usage = `usage: prctl <command> [arguments]
+
+commands:
+  team list
+  team show <team>
+  team add-member [-inactive] <team> <user_id> <username>
+  team remove-member <team> <user_id>
+  user activate <user_id>
+  user deactivate <user_id>
+  pr show <pr_id>
+  pr reassign <pr_id> <old_reviewer_id>
+  pr merge <pr_id>
+  stats [-team name] [-from RFC3339] [-to RFC3339]
+  migrate [-dir path] up|down|status
+
+Database settings are read from the same DB_* variables as the server.`
+
+var errUsage = errors.New("invalid usage")
+
+// app держит зависимости, общие для всех подкоманд.
+type app struct {
+	out io.Writer
+
+	pool               *pgxpool.Pool
+	migrateLockTimeout time.Duration
+	txProvider         db.Transactional
+	userRepo           user.Repository
+	teamRepo           team.Repository
+	prRepo             pullrequest.Repository
+	userService        *user.Service
+	teamService        *team.Service
+	prService          *pullrequest.Service
+	statsService       *stats.Service
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	cfg, err := config.FromEnv()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "prctl: load config: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	tracerProvider, shutdownTracing, err := tracing.NewProvider(ctx, cfg.Tracing)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "prctl: set up tracing: %v\n", err)
+		os.Exit(1)
+	}
+
+	pool, err := pgxadapter.NewPool(ctx, cfg.DB.URL(), pgxadapter.TracingConfig{
+		SlowQueryThreshold: cfg.DB.SlowQueryThreshold,
+		TracerProvider:     tracerProvider,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "prctl: connect to db: %v\n", err)
+		os.Exit(1)
+	}
+
+	err = newApp(cfg, pool, postgresRepositories(pool), os.Stdout).run(ctx, os.Args[1:])
+	pool.Close()
+	// os.Exit не выполняет defer, спаны нужно отправить до него
+	if err := shutdownTracing(context.WithoutCancel(ctx)); err != nil {
+		fmt.Fprintf(os.Stderr, "prctl: tracing shutdown: %v\n", err)
+	}
+	if errors.Is(err, errUsage) {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "prctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// repositories — хранилище и репозитории, с которыми работают подкоманды.
+type repositories struct {
+	db     db.TransactionalDB
+	users  user.Repository
+	teams  team.Repository
+	prs    pullrequest.Repository
+	audit  audit.Repository
+	outbox outbox.Repository
+	stats  stats.Repository
+}
+
+func postgresRepositories(pool *pgxpool.Pool) repositories {
+	dbAdapter := &pgxadapter.PoolAdapter{Pool: pool}
+	return repositories{
+		db:     dbAdapter,
+		users:  userRepo.NewPostgresRepository(dbAdapter),
+		teams:  teamRepo.NewPostgresRepository(dbAdapter),
+		prs:    prRepo.NewPostgresRepository(dbAdapter),
+		audit:  auditRepo.NewPostgresRepository(dbAdapter),
+		outbox: outboxRepo.NewPostgresRepository(dbAdapter),
+		stats:  statsRepo.NewPostgresRepository(dbAdapter),
+	}
+}
+
+// newApp принимает pool только для migrate, остальные подкоманды работают
+// через repos.
+func newApp(cfg config.Config, pool *pgxpool.Pool, repos repositories, out io.Writer) *app {
+	// подписчиков в утилите нет, события доставит dispatcher сервера из outbox
+	bus := eventbus.New(0)
+
+	return &app{
+		out:                out,
+		pool:               pool,
+		migrateLockTimeout: cfg.DB.MigrateLockTimeout,
+		txProvider:         repos.db,
+		userRepo:           repos.users,
+		teamRepo:           repos.teams,
+		prRepo:             repos.prs,
+		userService:        user.NewService(repos.users, repos.audit, repos.outbox, repos.db, bus),
+		teamService:        team.NewService(repos.teams, repos.users, repos.audit, repos.outbox, repos.db, bus),
+		prService: pullrequest.NewService(repos.prs, repos.users, repos.teams, repos.audit, repos.outbox, repos.db, bus, pullrequest.Config{
+			DefaultReviewSLA:    cfg.Review.SLA,
+			EscalationBatchSize: cfg.Scheduler.EscalationBatch,
+		}),
+		statsService: stats.NewService(repos.stats, stats.Config{
+			DefaultWindow:     cfg.Stats.Window,
+			FairnessTolerance: cfg.Stats.FairnessTolerance,
+		}),
+	}
+}
+
+func (a *app) run(ctx context.Context, args []string) error {
+	switch args[0] {
+	case "team":
+		return a.team(ctx, args[1:])
+	case "user":
+		return a.user(ctx, args[1:])
+	case "pr":
+		return a.pr(ctx, args[1:])
+	case "stats":
+		return a.stats(ctx, args[1:])
+	case "migrate":
+		return a.migrate(ctx, args[1:])
+	default:
+		return errUsage
+	}
+}
+
+// requireArgs проверяет число позиционных аргументов подкоманды.
+func requireArgs(args []string, n int) error {
+	if len(args) != n {
+		return errUsage
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/memory"
+	statsRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/stats"
+	"avito-backend-intern-assignment/internal/pkg/config"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestApp поднимает утилиту на хранилище в памяти с командой backend из
+// автора и трёх активных ревьюверов. wrap позволяет подменить репозиторий PR.
+func newTestApp(t *testing.T, wrap func(pullrequest.Repository) pullrequest.Repository) (*app, *bytes.Buffer) {
+	t.Helper()
+
+	store := memdb.New()
+	repos := repositories{
+		db:     store,
+		users:  memory.NewUserRepository(store),
+		teams:  memory.NewTeamRepository(store),
+		prs:    memory.NewPullRequestRepository(store),
+		audit:  memory.NewAuditRepository(store),
+		outbox: memory.NewOutboxRepository(store),
+		stats:  statsRepo.NewPostgresRepository(store),
+	}
+	if wrap != nil {
+		repos.prs = wrap(repos.prs)
+	}
+
+	var out bytes.Buffer
+	a := newApp(config.Config{Review: config.ReviewConfig{SLA: time.Hour}}, nil, repos, &out)
+
+	_, err := a.teamService.CreateTeam(context.Background(), entity.Team{TeamName: "backend", Members: []entity.TeamMember{
+		{UserId: "author", Username: "Author", IsActive: true},
+		{UserId: "r1", Username: "R1", IsActive: true},
+		{UserId: "r2", Username: "R2", IsActive: true},
+		{UserId: "r3", Username: "R3", IsActive: true},
+	}})
+	if err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+	return a, &out
+}
+
+func TestRun_Usage(t *testing.T) {
+	a, _ := newTestApp(t, nil)
+
+	tests := [][]string{
+		{"unknown"},
+		{"team"},
+		{"team", "rename", "backend"},
+		{"team", "show"},
+		{"team", "add-member", "backend", "u1"},
+		{"team", "add-member", "-admin", "backend", "u1", "Alice"},
+		{"team", "remove-member", "backend"},
+		{"team", "remove-member", "backend", "r1", "extra"},
+		{"user"},
+		{"user", "activate"},
+		{"user", "suspend", "r1"},
+		{"pr"},
+		{"pr", "show"},
+		{"pr", "reassign", "pr-1"},
+		{"pr", "merge", "pr-1", "extra"},
+		{"stats", "backend"},
+		{"migrate"},
+		{"migrate", "up", "down"},
+	}
+	for _, args := range tests {
+		t.Run(strings.Join(args, " "), func(t *testing.T) {
+			if err := a.run(context.Background(), args); !errors.Is(err, errUsage) {
+				t.Fatalf("expected errUsage, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRun_Dispatch(t *testing.T) {
+	a, out := newTestApp(t, nil)
+	ctx := context.Background()
+	if _, err := a.prService.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"}); err != nil {
+		t.Fatalf("create pr: %v", err)
+	}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"team", "add-member", "-inactive", "frontend", "u1", "Alice"}, "user u1 is now a member of frontend"},
+		{[]string{"team", "list"}, "frontend  1        0"},
+		{[]string{"team", "show", "frontend"}, "u1       Alice     false"},
+		{[]string{"user", "activate", "u1"}, "user u1 (Alice, team frontend) active: true"},
+		{[]string{"user", "deactivate", "u1"}, "user u1 (Alice, team frontend) active: false"},
+		{[]string{"pr", "show", "pr-1"}, "author:  author"},
+		{[]string{"pr", "merge", "pr-1"}, "PR pr-1 is MERGED"},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			out.Reset()
+			if err := a.run(ctx, tt.args); err != nil {
+				t.Fatalf("run: %v", err)
+			}
+			if !strings.Contains(out.String(), tt.want) {
+				t.Fatalf("expected %q in output:\n%s", tt.want, out.String())
+			}
+		})
+	}
+
+	if err := a.run(ctx, []string{"pr", "show", "ghost"}); !errors.Is(err, pullrequest.ErrPullRequestNotFound) {
+		t.Fatalf("expected ErrPullRequestNotFound, got %v", err)
+	}
+}
+
+// brokenReplaceRepository отказывает на переназначении ревьювера.
+type brokenReplaceRepository struct {
+	pullrequest.Repository
+}
+
+func (r brokenReplaceRepository) WithDB(d db.DB) pullrequest.Repository {
+	return brokenReplaceRepository{r.Repository.WithDB(d)}
+}
+
+func (r brokenReplaceRepository) ReplaceReviewer(context.Context, string, string, string, time.Time) error {
+	return errors.New("connection reset")
+}
+
+func TestTeamRemoveMember(t *testing.T) {
+	ctx := context.Background()
+
+	a, out := newTestApp(t, nil)
+	created, err := a.prService.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create pr: %v", err)
+	}
+	reviewer := created.AssignedReviewers[0]
+
+	if err := a.run(ctx, []string{"team", "remove-member", "frontend", reviewer}); err == nil {
+		t.Fatal("expected error for a user of another team")
+	}
+	if err := a.run(ctx, []string{"team", "remove-member", "backend", reviewer}); err != nil {
+		t.Fatalf("remove member: %v", err)
+	}
+	if !strings.Contains(out.String(), "user "+reviewer+" deactivated") || !strings.Contains(out.String(), "PR pr-1: "+reviewer+" replaced by") {
+		t.Fatalf("unexpected output:\n%s", out.String())
+	}
+	if u, _ := a.userRepo.GetByID(ctx, reviewer); u == nil || u.IsActive {
+		t.Fatalf("expected inactive %s, got %+v", reviewer, u)
+	}
+	if pr, _ := a.prRepo.GetByID(ctx, "pr-1"); pr == nil || slices.Contains(pr.AssignedReviewers, reviewer) {
+		t.Fatalf("expected %s to be replaced, got %+v", reviewer, pr)
+	}
+
+	// отказ переназначения откатывает и деактивацию
+	a, out = newTestApp(t, func(r pullrequest.Repository) pullrequest.Repository { return brokenReplaceRepository{r} })
+	created, err = a.prService.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create pr: %v", err)
+	}
+	reviewer = created.AssignedReviewers[0]
+
+	if err := a.run(ctx, []string{"team", "remove-member", "backend", reviewer}); err == nil {
+		t.Fatal("expected reassign error")
+	}
+	if out.Len() != 0 {
+		t.Fatalf("nothing must be reported on failure, got:\n%s", out.String())
+	}
+	if u, _ := a.userRepo.GetByID(ctx, reviewer); u == nil || !u.IsActive {
+		t.Fatalf("deactivation must be rolled back: %+v", u)
+	}
+	if pr, _ := a.prRepo.GetByID(ctx, "pr-1"); pr == nil || !slices.Contains(pr.AssignedReviewers, reviewer) {
+		t.Fatalf("reviewers must be unchanged: %+v", pr)
+	}
+}
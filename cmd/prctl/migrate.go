@@ -0,0 +1,73 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/pkg/migrate"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pressly/goose/v3"
+)
+
+func (a *app) migrate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	dir := fs.String("dir", "", "directory with goose migrations, embedded ones if empty")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if err := requireArgs(fs.Args(), 1); err != nil {
+		return err
+	}
+
+	cfg := migrate.Config{LockTimeout: a.migrateLockTimeout}
+	if *dir != "" {
+		cfg.FS = os.DirFS(*dir)
+	}
+	provider, err := migrate.NewProvider(a.pool, cfg)
+	if err != nil {
+		return err
+	}
+	defer provider.Close()
+
+	switch fs.Arg(0) {
+	case "up":
+		results, err := provider.Up(ctx)
+		for _, r := range results {
+			fmt.Fprintln(a.out, r)
+		}
+		if err != nil {
+			return err
+		}
+		if len(results) == 0 {
+			fmt.Fprintln(a.out, "no migrations to apply")
+		}
+		return nil
+	case "down":
+		result, err := provider.Down(ctx)
+		if result != nil {
+			fmt.Fprintln(a.out, result)
+		}
+		return err
+	case "status":
+		statuses, err := provider.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "APPLIED_AT\tMIGRATION")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.State == goose.StateApplied {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%s\t%s\n", appliedAt, s.Source.Path)
+		}
+		return w.Flush()
+	default:
+		return errUsage
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+	"time"
+)
+
+func (a *app) pr(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "show":
+		if err := requireArgs(args[1:], 1); err != nil {
+			return err
+		}
+		return a.prShow(ctx, args[1])
+	case "reassign":
+		if err := requireArgs(args[1:], 2); err != nil {
+			return err
+		}
+		pr, newReviewerID, err := a.prService.ReassignReviewer(ctx, args[1], args[2])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(a.out, "PR %s: %s replaced by %s\n", pr.PullRequestId, args[2], newReviewerID)
+		return nil
+	case "merge":
+		if err := requireArgs(args[1:], 1); err != nil {
+			return err
+		}
+		pr, err := a.prService.MarkMerged(ctx, args[1])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(a.out, "PR %s is %s\n", pr.PullRequestId, pr.Status)
+		return nil
+	default:
+		return errUsage
+	}
+}
+
+func (a *app) prShow(ctx context.Context, prID string) error {
+	pr, err := a.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return fmt.Errorf("get pr: %w", err)
+	}
+	if pr == nil {
+		return pullrequest.ErrPullRequestNotFound
+	}
+
+	fmt.Fprintf(a.out, "id:      %s\n", pr.PullRequestId)
+	fmt.Fprintf(a.out, "name:    %s\n", pr.PullRequestName)
+	fmt.Fprintf(a.out, "author:  %s\n", pr.AuthorId)
+	fmt.Fprintf(a.out, "status:  %s\n", pr.Status)
+	if pr.CreatedAt != nil {
+		fmt.Fprintf(a.out, "created: %s\n", pr.CreatedAt.Format(time.RFC3339))
+	}
+	if pr.MergedAt != nil {
+		fmt.Fprintf(a.out, "merged:  %s\n", pr.MergedAt.Format(time.RFC3339))
+	}
+	fmt.Fprintln(a.out)
+
+	w := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "REVIEWER\tASSIGNED_AT\tDECISION\tDECIDED_AT")
+	for _, r := range pr.Reviews {
+		decision, decidedAt := "-", "-"
+		if r.Decision != "" {
+			decision = string(r.Decision)
+		}
+		if r.DecidedAt != nil {
+			decidedAt = r.DecidedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.ReviewerID, r.AssignedAt.Format(time.RFC3339), decision, decidedAt)
+	}
+	return w.Flush()
+}
+
+// releaseReviews переназначает открытые ревью пользователя и возвращает
+// строки отчёта. PR без кандидата на замену попадают в отчёт, чтобы дежурный
+// разобрался с ними вручную.
+func (a *app) releaseReviews(ctx context.Context, userID string) ([]string, error) {
+	prs, err := a.prRepo.GetByAssignedReviewer(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get reviews: %w", err)
+	}
+
+	var report []string
+	for _, pr := range prs {
+		if pr.Status != entity.PullRequestStatusOPEN {
+			continue
+		}
+
+		_, newReviewerID, err := a.prService.ReassignReviewer(ctx, pr.PullRequestId, userID)
+		switch {
+		case errors.Is(err, pullrequest.ErrReassignViolation), errors.Is(err, pullrequest.ErrNotEnoughReviewers):
+			report = append(report, fmt.Sprintf("PR %s: no replacement for %s, reassign manually", pr.PullRequestId, userID))
+		case err != nil:
+			return nil, fmt.Errorf("reassign PR %s: %w", pr.PullRequestId, err)
+		default:
+			report = append(report, fmt.Sprintf("PR %s: %s replaced by %s", pr.PullRequestId, userID, newReviewerID))
+		}
+	}
+	return report, nil
+}
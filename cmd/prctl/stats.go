@@ -0,0 +1,76 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+	"time"
+)
+
+func (a *app) stats(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	teamName := fs.String("team", "", "show per-reviewer statistics of one team")
+	from := fs.String("from", "", "window start, RFC3339")
+	to := fs.String("to", "", "window end, RFC3339")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if err := requireArgs(fs.Args(), 0); err != nil {
+		return err
+	}
+
+	var window entity.StatsWindow
+	var err error
+	if window.From, err = parseTime(*from); err != nil {
+		return fmt.Errorf("invalid -from: %w", err)
+	}
+	if window.To, err = parseTime(*to); err != nil {
+		return fmt.Errorf("invalid -to: %w", err)
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+	if *teamName != "" {
+		window, reviewers, err := a.statsService.ReviewerStats(ctx, window, *teamName)
+		if err != nil {
+			return err
+		}
+		a.printWindow(window)
+		fmt.Fprintln(w, "USER_ID\tUSERNAME\tOPEN\tTOTAL\tREASSIGNED_AWAY\tMERGED\tMEDIAN_TO_MERGE")
+		for _, s := range reviewers {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", s.UserID, s.Username, formatReviewStats(s.ReviewStats))
+		}
+		return w.Flush()
+	}
+
+	window, teams, err := a.statsService.TeamStats(ctx, window)
+	if err != nil {
+		return err
+	}
+	a.printWindow(window)
+	fmt.Fprintln(w, "TEAM\tOPEN\tTOTAL\tREASSIGNED_AWAY\tMERGED\tMEDIAN_TO_MERGE")
+	for _, s := range teams {
+		fmt.Fprintf(w, "%s\t%s\n", s.TeamName, formatReviewStats(s.ReviewStats))
+	}
+	return w.Flush()
+}
+
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func (a *app) printWindow(window entity.StatsWindow) {
+	fmt.Fprintf(a.out, "window: %s .. %s\n\n", window.From.Format(time.RFC3339), window.To.Format(time.RFC3339))
+}
+
+func formatReviewStats(s entity.ReviewStats) string {
+	median := "-"
+	if s.MedianTimeToMerge != nil {
+		median = s.MedianTimeToMerge.Round(time.Minute).String()
+	}
+	return fmt.Sprintf("%d\t%d\t%d\t%d\t%s", s.OpenAssignments, s.TotalAssignments, s.ReassignedAway, s.MergedReviewed, median)
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"flag"
+	"fmt"
+	"text/tabwriter"
+)
+
+func (a *app) team(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	switch args[0] {
+	case "list":
+		return a.teamList(ctx)
+	case "show":
+		if err := requireArgs(args[1:], 1); err != nil {
+			return err
+		}
+		return a.teamShow(ctx, args[1])
+	case "add-member":
+		return a.teamAddMember(ctx, args[1:])
+	case "remove-member":
+		if err := requireArgs(args[1:], 2); err != nil {
+			return err
+		}
+		return a.teamRemoveMember(ctx, args[1], args[2])
+	default:
+		return errUsage
+	}
+}
+
+func (a *app) teamList(ctx context.Context) error {
+	teams, err := a.teamRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list teams: %w", err)
+	}
+	users, err := a.userRepo.List(ctx)
+	if err != nil {
+		return fmt.Errorf("list users: %w", err)
+	}
+
+	members := make(map[string]int)
+	active := make(map[string]int)
+	for _, u := range users {
+		members[u.TeamName]++
+		if u.IsActive {
+			active[u.TeamName]++
+		}
+	}
+
+	w := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "TEAM\tMEMBERS\tACTIVE")
+	for _, t := range teams {
+		fmt.Fprintf(w, "%s\t%d\t%d\n", t, members[t], active[t])
+	}
+	return w.Flush()
+}
+
+func (a *app) teamShow(ctx context.Context, teamName string) error {
+	t, err := a.teamService.GetTeamWithMembers(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	sla, err := a.teamRepo.GetReviewSLA(ctx, teamName)
+	if err != nil {
+		return fmt.Errorf("get review sla: %w", err)
+	}
+	fmt.Fprintf(a.out, "team: %s\n", t.TeamName)
+	if sla != nil {
+		fmt.Fprintf(a.out, "review sla: %s\n", *sla)
+	}
+	fmt.Fprintln(a.out)
+
+	w := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "USER_ID\tUSERNAME\tACTIVE")
+	for _, m := range t.Members {
+		fmt.Fprintf(w, "%s\t%s\t%t\n", m.UserId, m.Username, m.IsActive)
+	}
+	return w.Flush()
+}
+
+// teamAddMember создаёт команду при необходимости; существующий пользователь
+// переводится в неё из прежней команды.
+func (a *app) teamAddMember(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("team add-member", flag.ContinueOnError)
+	inactive := fs.Bool("inactive", false, "add the member as inactive")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if err := requireArgs(fs.Args(), 3); err != nil {
+		return err
+	}
+
+	teamName, userID, username := fs.Arg(0), fs.Arg(1), fs.Arg(2)
+	_, err := a.teamService.CreateTeam(ctx, entity.Team{
+		TeamName: teamName,
+		Members:  []entity.TeamMember{{UserId: userID, Username: username, IsActive: !*inactive}},
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "user %s is now a member of %s\n", userID, teamName)
+	return nil
+}
+
+// teamRemoveMember деактивирует участника и снимает его с открытых PR:
+// пользователь не может существовать вне команды, поэтому удаление из неё
+// означает вывод из ротации ревьюверов. Всё выполняется одной транзакцией,
+// чтобы пользователь не остался выключенным, но с назначенными ревью.
+func (a *app) teamRemoveMember(ctx context.Context, teamName, userID string) error {
+	var report []string
+	err := db.WithTxRetry(ctx, a.txProvider, pullrequest.AssignmentTxOptions, db.DefaultRetryPolicy, func(ctx context.Context, _ db.Tx) error {
+		u, err := a.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get user: %w", err)
+		}
+		if u == nil || u.TeamName != teamName {
+			return fmt.Errorf("user %s is not a member of %s", userID, teamName)
+		}
+
+		if _, err := a.userService.SetIsActive(ctx, userID, false); err != nil {
+			return err
+		}
+		report, err = a.releaseReviews(ctx, userID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(a.out, "user %s deactivated\n", userID)
+	for _, line := range report {
+		fmt.Fprintln(a.out, line)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+)
+
+func (a *app) user(ctx context.Context, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	var isActive bool
+	switch args[0] {
+	case "activate":
+		isActive = true
+	case "deactivate":
+		isActive = false
+	default:
+		return errUsage
+	}
+
+	u, err := a.userService.SetIsActive(ctx, args[1], isActive)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(a.out, "user %s (%s, team %s) active: %t\n", u.UserId, u.Username, u.TeamName, u.IsActive)
+	return nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/export"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// runExport реализует подкоманду `server export [-format csv|ndjson] [-o file] <dataset>`
+// для ночных выгрузок без поднятия HTTP-сервера.
+func runExport(ctx context.Context, exportService *export.Service, args []string) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := fs.String("format", string(entity.ExportCSV), "output format: csv or ndjson")
+	out := fs.String("o", "", "output file, stdout if empty")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: server export [-format csv|ndjson] [-o file] <teams|users|pull_requests|assignments|reassignments>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("exactly one dataset is required")
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return fmt.Errorf("create output: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	buf := bufio.NewWriter(w)
+	if err := exportService.Export(ctx, entity.ExportDataset(fs.Arg(0)), entity.ExportFormat(*format), buf); err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
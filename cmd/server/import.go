@@ -0,0 +1,85 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// runImportTeams реализует подкоманду `server import-teams [-dry-run] [-format csv|yaml] <file>`,
+// аналог POST /team/import для миграций оргструктуры.
+func runImportTeams(ctx context.Context, teamService *team.Service, args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("import-teams", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "only print the plan, do not apply it")
+	format := fs.String("format", "", "file format: csv or yaml, detected by extension if empty")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: server import-teams [-dry-run] [-format csv|yaml] <file>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errors.New("exactly one file is required")
+	}
+
+	path := fs.Arg(0)
+	if *format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			*format = string(team.ImportCSV)
+		case ".yaml", ".yml":
+			*format = string(team.ImportYAML)
+		default:
+			return fmt.Errorf("cannot detect format of %s, use -format", path)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+
+	teams, err := team.ParseImport(f, team.ImportFormat(*format))
+	if err != nil {
+		return err
+	}
+
+	plan, err := teamService.Import(ctx, teams, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	printImportPlan(out, plan)
+	return nil
+}
+
+func printImportPlan(w io.Writer, plan entity.TeamImportPlan) {
+	for _, c := range plan.Changes {
+		switch c.Kind {
+		case entity.TeamImportCreateTeam:
+			fmt.Fprintf(w, "+ team %s\n", c.TeamName)
+		case entity.TeamImportCreateUser:
+			fmt.Fprintf(w, "+ user %s -> %s\n", c.UserID, c.TeamName)
+		case entity.TeamImportUpdateUser:
+			fmt.Fprintf(w, "~ user %s in %s\n", c.UserID, c.TeamName)
+		case entity.TeamImportMoveUser:
+			fmt.Fprintf(w, "> user %s: %s -> %s\n", c.UserID, c.FromTeamName, c.TeamName)
+		}
+	}
+
+	status := "applied"
+	if !plan.Applied {
+		status = "dry run, nothing applied"
+	}
+	fmt.Fprintf(w, "%d change(s), %s\n", len(plan.Changes), status)
+}
@@ -3,57 +3,270 @@ package main
 import (
 	"avito-backend-intern-assignment/internal/app/api"
 	"avito-backend-intern-assignment/internal/app/api/handlers"
+	aHandler "avito-backend-intern-assignment/internal/app/api/handlers/audit"
+	dHandler "avito-backend-intern-assignment/internal/app/api/handlers/directory"
+	eHandler "avito-backend-intern-assignment/internal/app/api/handlers/events"
+	xHandler "avito-backend-intern-assignment/internal/app/api/handlers/export"
+	nHandler "avito-backend-intern-assignment/internal/app/api/handlers/notification"
 	prHandler "avito-backend-intern-assignment/internal/app/api/handlers/pullrequest"
+	statsHandler "avito-backend-intern-assignment/internal/app/api/handlers/stats"
 	tHandler "avito-backend-intern-assignment/internal/app/api/handlers/team"
 	uHandler "avito-backend-intern-assignment/internal/app/api/handlers/user"
+	vcsHandler "avito-backend-intern-assignment/internal/app/api/handlers/vcs"
+	wHandler "avito-backend-intern-assignment/internal/app/api/handlers/webhook"
+	"avito-backend-intern-assignment/internal/app/api/middleware"
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/application/service/directory"
+	"avito-backend-intern-assignment/internal/app/application/service/export"
+	"avito-backend-intern-assignment/internal/app/application/service/idempotency"
+	"avito-backend-intern-assignment/internal/app/application/service/notification"
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
 	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/reminder"
+	"avito-backend-intern-assignment/internal/app/application/service/scheduler"
+	"avito-backend-intern-assignment/internal/app/application/service/stats"
 	"avito-backend-intern-assignment/internal/app/application/service/team"
 	"avito-backend-intern-assignment/internal/app/application/service/user"
-	prRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/pullrequest"
-	teamRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/team"
-	userRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/user"
+	"avito-backend-intern-assignment/internal/app/application/service/vcs"
+	"avito-backend-intern-assignment/internal/app/application/service/webhook"
+	"avito-backend-intern-assignment/internal/app/infrastructure/notifier"
+	exportRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/export"
+	notificationRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/notification"
+	reminderRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/reminder"
+	schedulerRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/scheduler"
+	statsRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/stats"
+	vcsRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/vcs"
+	webhookRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/webhook"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim"
+	"avito-backend-intern-assignment/internal/app/infrastructure/sink"
 	"avito-backend-intern-assignment/internal/pkg/config"
-	"avito-backend-intern-assignment/pkg/db/pgxadapter"
+	"avito-backend-intern-assignment/internal/pkg/tracing"
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"time"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// idempotentRoutes принимают заголовок Idempotency-Key.
+var idempotentRoutes = []string{
+	"POST /pullRequest/create",
+	"POST /pullRequest/reassign",
+	"POST /team/add",
+	"POST /users/setIsActive",
+}
+
 func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run возвращает ошибку подкоманды вместо log.Fatalf, чтобы отложенные
+// закрытие хранилища и остановка трассировки успели выполниться.
+func run() error {
+	migrateOnly := flag.Bool("migrate-only", false, "apply embedded migrations and exit")
+	flag.Parse()
+
 	cfg, err := config.FromEnv()
 	if err != nil {
 		log.Fatalf("failed to load config: %v", err)
 	}
 
-	pool, err := pgxpool.New(context.Background(), cfg.DB.URL())
+	tracerProvider, shutdownTracing, err := tracing.NewProvider(context.Background(), cfg.Tracing)
 	if err != nil {
-		log.Fatalf("failed to connect to db: %v", err)
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
+
+	st, err := openStorage(context.Background(), cfg, tracerProvider, *migrateOnly)
+	if err != nil {
+		log.Fatalf("failed to open storage: %v", err)
+	}
+	defer st.close()
+	if *migrateOnly {
+		return nil
 	}
-	defer pool.Close()
 
-	dbAdapter := &pgxadapter.PoolAdapter{Pool: pool}
+	dbAdapter := st.db
+	userRepo := st.users
+	teamRepo := st.teams
+	prRepo := st.prs
+	auditRepo := st.audit
+	outboxRepo := st.outbox
+	webhookRepo := webhookRepo.NewPostgresRepository(dbAdapter)
+	identityRepo := vcsRepo.NewPostgresRepository(dbAdapter)
+	notificationRepo := notificationRepo.NewPostgresRepository(dbAdapter)
+	reminderRepo := reminderRepo.NewPostgresRepository(dbAdapter)
+	schedulerRepo := schedulerRepo.NewPostgresRepository(dbAdapter)
+	statsRepo := statsRepo.NewPostgresRepository(dbAdapter)
 
-	userRepo := userRepo.NewPostgresRepository(dbAdapter)
-	teamRepo := teamRepo.NewPostgresRepository(dbAdapter)
-	prRepo := prRepo.NewPostgresRepository(dbAdapter)
+	bus := eventbus.New(cfg.Stream.BufferSize)
 
-	userService := user.NewService(userRepo)
-	teamService := team.NewService(teamRepo, userRepo, dbAdapter)
-	prService := pullrequest.NewService(prRepo, userRepo, dbAdapter)
+	userService := user.NewService(userRepo, auditRepo, outboxRepo, dbAdapter, bus)
+	teamService := team.NewService(teamRepo, userRepo, auditRepo, outboxRepo, dbAdapter, bus)
+	prService := pullrequest.NewService(prRepo, userRepo, teamRepo, auditRepo, outboxRepo, dbAdapter, bus, pullrequest.Config{
+		DefaultReviewSLA:    cfg.Review.SLA,
+		EscalationBatchSize: cfg.Scheduler.EscalationBatch,
+	})
+	auditService := audit.NewService(auditRepo)
+	webhookService := webhook.NewService(webhookRepo, teamRepo)
+	vcsService := vcs.NewService(identityRepo, userRepo, prService)
+	notificationService := notification.NewService(notificationRepo, teamRepo, userRepo)
+	statsService := stats.NewService(statsRepo, stats.Config{
+		DefaultWindow:     cfg.Stats.Window,
+		FairnessTolerance: cfg.Stats.FairnessTolerance,
+	})
+	exportService := export.NewService(exportRepo.NewPostgresRepository(dbAdapter))
+	idempotencyService := idempotency.NewService(st.idempotency, idempotency.Config{
+		TTL:         cfg.Idempotency.TTL,
+		LockTimeout: cfg.Idempotency.LockTimeout,
+	})
+
+	var directorySource directory.Source
+	if cfg.Directory.SCIMURL != "" {
+		directorySource = scim.NewClient(scim.Config{
+			BaseURL:  cfg.Directory.SCIMURL,
+			Token:    cfg.Directory.SCIMToken,
+			PageSize: cfg.Directory.SCIMPageSize,
+			Timeout:  cfg.Directory.SCIMTimeout,
+		})
+	}
+	directoryService := directory.NewService(directorySource, userRepo, teamService, userService, prService, dbAdapter, directory.Config{
+		MaxDeactivations: cfg.Directory.MaxDeactivations,
+	})
+
+	if args := flag.Args(); len(args) > 0 {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		defer func() {
+			if err := shutdownTracing(context.Background()); err != nil {
+				log.Printf("tracing shutdown failed: %v", err)
+			}
+		}()
+
+		switch args[0] {
+		case "export":
+			if st.inMemory() {
+				return errors.New("export is not available with STORAGE=memory")
+			}
+			err = runExport(ctx, exportService, args[1:])
+		case "import-teams":
+			err = runImportTeams(ctx, teamService, args[1:], os.Stdout)
+		default:
+			return fmt.Errorf("unknown command %q", args[0])
+		}
+		if err != nil {
+			return fmt.Errorf("%s failed: %w", args[0], err)
+		}
+		return nil
+	}
+
+	notifiers := []notification.Notifier{notifier.NewChatNotifier(cfg.Notify.ChatTimeout)}
+	if cfg.Notify.SMTPHost != "" {
+		notifiers = append(notifiers, notifier.NewSMTPNotifier(notifier.SMTPConfig{
+			Host:     cfg.Notify.SMTPHost,
+			Port:     cfg.Notify.SMTPPort,
+			Username: cfg.Notify.SMTPUsername,
+			Password: cfg.Notify.SMTPPassword,
+			From:     cfg.Notify.SMTPFrom,
+			Timeout:  cfg.Notify.SMTPTimeout,
+		}))
+	}
+
+	sinks := []outbox.Sink{sink.NewLogSink()}
+	if !st.inMemory() {
+		sinks = append(sinks, webhook.NewSink(webhookRepo), notification.NewSink(notificationRepo, notifiers...))
+	}
+	dispatcher := outbox.NewDispatcher(outboxRepo, dbAdapter, outbox.DispatcherConfig{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		BaseBackoff:  cfg.Outbox.BaseBackoff,
+		MaxBackoff:   cfg.Outbox.MaxBackoff,
+	}, sinks...)
+
+	deliverer := webhook.NewDeliverer(webhookRepo, dbAdapter, webhook.NewClient(cfg.Webhook.Timeout), webhook.DelivererConfig{
+		PollInterval: cfg.Webhook.PollInterval,
+		BatchSize:    cfg.Webhook.BatchSize,
+		MaxAttempts:  cfg.Webhook.MaxAttempts,
+		BaseBackoff:  cfg.Webhook.BaseBackoff,
+		MaxBackoff:   cfg.Webhook.MaxBackoff,
+	})
+
+	bgCtx, stopBackground := context.WithCancel(context.Background())
+	var bg sync.WaitGroup
+	bg.Go(func() {
+		dispatcher.Run(bgCtx)
+	})
+	// доставка вебхуков и задачи планировщика работают только с Postgres
+	if !st.inMemory() {
+		bg.Go(func() {
+			deliverer.Run(bgCtx)
+		})
+	}
+
+	if cfg.Scheduler.Enabled && !st.inMemory() {
+		reminderService := reminder.NewService(reminderRepo, outboxRepo, reminder.Config{
+			SLA:       cfg.Review.SLA,
+			Interval:  cfg.Scheduler.ReminderInterval,
+			BatchSize: cfg.Scheduler.ReminderBatch,
+		})
+		digest := notification.NewDigest(notificationRepo, prRepo, notifiers...)
+
+		jobs := []scheduler.Job{
+			{Name: "review-reminders", Schedule: cfg.Scheduler.ReminderSchedule, Run: reminderService.RemindStale},
+			{Name: "review-digest", Schedule: cfg.Scheduler.DigestSchedule, Run: digest.Send},
+			{Name: "review-escalation", Schedule: cfg.Scheduler.EscalationSchedule, Run: prService.EscalateOverdue},
+			{Name: "idempotency-cleanup", Schedule: cfg.Idempotency.CleanupSchedule, Run: idempotencyService.Cleanup},
+		}
+		if directorySource != nil {
+			jobs = append(jobs, scheduler.Job{Name: "directory-sync", Schedule: cfg.Directory.SyncSchedule, Run: directoryService.RunScheduled})
+		}
+
+		sched, err := scheduler.New(schedulerRepo, dbAdapter, jobs...)
+		if err != nil {
+			log.Fatalf("failed to configure scheduler: %v", err)
+		}
+		bg.Go(func() {
+			sched.Run(bgCtx)
+		})
+	}
 
 	prh := prHandler.NewHandler(prService)
 	uh := uHandler.NewHandler(userService)
 	th := tHandler.NewHandler(teamService)
+	ah := aHandler.NewHandler(auditService)
+	wh := wHandler.NewHandler(webhookService)
+	vh := vcsHandler.NewHandler(vcsService)
+	nh := nHandler.NewHandler(notificationService)
+	sth := statsHandler.NewHandler(statsService)
+	vwh := vcsHandler.NewWebhookHandler(vcsService, cfg.VCS.GitHubSecret, cfg.VCS.GitLabToken)
+	sh := eHandler.NewStreamHandler(bus, cfg.Stream.HeartbeatInterval)
+	dh := dHandler.NewHandler(directoryService)
+	xh := xHandler.NewHandler(exportService)
 
-	h := handlers.NewApiV1(th, uh, prh)
+	h := handlers.NewApiV1(th, uh, prh, ah, wh, vh, nh, sth, dh)
 	r := chi.NewRouter()
+	r.Use(middleware.RequestContext)
+	r.Use(middleware.Idempotency(idempotencyService, idempotentRoutes...))
+
+	r.Get("/events/stream", sh.Stream)
+	if st.inMemory() {
+		for _, route := range postgresOnlyRoutes {
+			r.HandleFunc(route, notInMemory)
+		}
+	} else {
+		r.Post("/vcs/github", vwh.GitHub)
+		r.Post("/vcs/gitlab", vwh.GitLab)
+		r.Get("/export/{dataset}", xh.Export)
+	}
 
 	apiHandler := api.NewStrictHandler(h, nil)
 
@@ -64,6 +277,8 @@ func main() {
 		Addr:    serverAddr,
 		Handler: r,
 	}
+	// SSE-соединения не завершаются сами, Shutdown ждал бы их до таймаута
+	srv.RegisterOnShutdown(bus.Close)
 
 	go func() {
 		log.Printf("server listening on %s", serverAddr)
@@ -82,4 +297,14 @@ func main() {
 	if err := srv.Shutdown(ctx); err != nil {
 		log.Fatalf("server shutdown failed: %v", err)
 	}
+
+	stopBackground()
+	bg.Wait()
+
+	// спаны фоновых задач тоже должны уйти, поэтому экспорт останавливается
+	// после них, а не в RegisterOnShutdown
+	if err := shutdownTracing(ctx); err != nil {
+		log.Printf("tracing shutdown failed: %v", err)
+	}
+	return nil
 }
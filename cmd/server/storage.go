@@ -0,0 +1,104 @@
+package main
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/application/service/idempotency"
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	auditRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/audit"
+	idempotencyRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/idempotency"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/memory"
+	outboxRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/outbox"
+	prRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/pullrequest"
+	teamRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/team"
+	userRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/user"
+	"avito-backend-intern-assignment/internal/pkg/config"
+	"avito-backend-intern-assignment/internal/pkg/migrate"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"avito-backend-intern-assignment/pkg/db/pgxadapter"
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// storage — репозитории, реализация которых зависит от STORAGE. Остальные
+// Postgres-репозитории создаются поверх storage.db: в режиме memory их запросы
+// завершаются memdb.ErrSQLNotSupported.
+type storage struct {
+	db          db.TransactionalDB
+	users       user.Repository
+	teams       team.Repository
+	prs         pullrequest.Repository
+	audit       audit.Repository
+	outbox      outbox.Repository
+	idempotency idempotency.Repository
+	close       func()
+}
+
+func (s storage) inMemory() bool {
+	_, ok := s.db.(*memdb.DB)
+	return ok
+}
+
+// postgresOnlyRoutes работают с таблицами, которых нет в памяти: вебхуки,
+// интеграция с VCS, уведомления, статистика и выгрузка.
+var postgresOnlyRoutes = []string{"/webhooks/*", "/vcs/*", "/notifications/*", "/stats/*", "/export/*"}
+
+// notInMemory отвечает на postgresOnlyRoutes в режиме memory: 501 вместо 500
+// от memdb.ErrSQLNotSupported.
+func notInMemory(w http.ResponseWriter, _ *http.Request) {
+	http.Error(w, "not available with STORAGE=memory", http.StatusNotImplemented)
+}
+
+func openStorage(ctx context.Context, cfg config.Config, tracerProvider trace.TracerProvider, migrateOnly bool) (storage, error) {
+	if cfg.Storage == config.StorageMemory {
+		log.Printf("STORAGE=memory: data is kept in process memory and lost on restart; " +
+			"teams, users, pull requests, reviews, audit, idempotency, the event stream and directory sync are available; " +
+			"webhooks, VCS integration, notifications, statistics, export and scheduled jobs are not")
+
+		store := memdb.New()
+		return storage{
+			db:          store,
+			users:       memory.NewUserRepository(store),
+			teams:       memory.NewTeamRepository(store),
+			prs:         memory.NewPullRequestRepository(store),
+			audit:       memory.NewAuditRepository(store),
+			outbox:      memory.NewOutboxRepository(store),
+			idempotency: memory.NewIdempotencyRepository(store),
+			close:       func() {},
+		}, nil
+	}
+
+	pool, err := pgxadapter.NewPool(ctx, cfg.DB.URL(), pgxadapter.TracingConfig{
+		SlowQueryThreshold: cfg.DB.SlowQueryThreshold,
+		TracerProvider:     tracerProvider,
+	})
+	if err != nil {
+		return storage{}, fmt.Errorf("connect to db: %w", err)
+	}
+
+	if migrateOnly || cfg.DB.MigrateOnStart {
+		if err := migrate.Up(ctx, pool, migrate.Config{LockTimeout: cfg.DB.MigrateLockTimeout}); err != nil {
+			pool.Close()
+			return storage{}, fmt.Errorf("migrate db: %w", err)
+		}
+	}
+
+	adapter := &pgxadapter.PoolAdapter{Pool: pool}
+	return storage{
+		db:          adapter,
+		users:       userRepo.NewPostgresRepository(adapter),
+		teams:       teamRepo.NewPostgresRepository(adapter),
+		prs:         prRepo.NewPostgresRepository(adapter),
+		audit:       auditRepo.NewPostgresRepository(adapter),
+		outbox:      outboxRepo.NewPostgresRepository(adapter),
+		idempotency: idempotencyRepo.NewPostgresRepository(adapter),
+		close:       pool.Close,
+	}, nil
+}
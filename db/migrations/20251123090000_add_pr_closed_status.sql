@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+ALTER TYPE pr_status ADD VALUE IF NOT EXISTS 'CLOSED';
+
+-- +goose Down
+-- значение из enum в PostgreSQL удалить нельзя, поэтому откатываем только данные
+UPDATE pullrequests SET status = 'OPEN' WHERE status = 'CLOSED';
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS team_notification_settings (
+    team_name TEXT PRIMARY KEY REFERENCES teams (team_name) ON DELETE CASCADE,
+    chat_webhook_url TEXT NOT NULL DEFAULT '',
+    mode TEXT NOT NULL DEFAULT 'INSTANT',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS user_notification_preferences (
+    user_id TEXT PRIMARY KEY REFERENCES users (id) ON DELETE CASCADE,
+    email TEXT NOT NULL DEFAULT '',
+    mode TEXT NOT NULL DEFAULT 'INSTANT',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id BIGSERIAL PRIMARY KEY,
+    outbox_message_id BIGINT NOT NULL,
+    channel TEXT NOT NULL,
+    recipient TEXT NOT NULL,
+    mode TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_at TIMESTAMPTZ,
+    UNIQUE (outbox_message_id, channel, recipient)
+);
+
+CREATE INDEX IF NOT EXISTS notifications_digest_idx ON notifications (channel, recipient, id) WHERE sent_at IS NULL AND mode = 'DIGEST';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS notifications;
+DROP TABLE IF EXISTS user_notification_preferences;
+DROP TABLE IF EXISTS team_notification_settings;
+-- +goose StatementEnd
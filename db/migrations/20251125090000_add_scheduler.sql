@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS review_reminders (
+    pr_id TEXT NOT NULL REFERENCES pullrequests (id) ON DELETE CASCADE,
+    reviewer_id TEXT NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    reminded_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (pr_id, reviewer_id)
+);
+
+CREATE TABLE IF NOT EXISTS scheduler_jobs (
+    name TEXT PRIMARY KEY,
+    last_run_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS scheduler_jobs;
+DROP TABLE IF EXISTS review_reminders;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE assigned_pr_reviewers
+    ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS decision TEXT,
+    ADD COLUMN IF NOT EXISTS decided_at TIMESTAMPTZ;
+
+UPDATE assigned_pr_reviewers apr
+SET assigned_at = COALESCE(pr.created_at, now())
+FROM pullrequests pr
+WHERE pr.id = apr.pr_id AND apr.assigned_at IS NULL;
+
+ALTER TABLE assigned_pr_reviewers
+    ALTER COLUMN assigned_at SET DEFAULT now(),
+    ALTER COLUMN assigned_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS assigned_pr_reviewers_pending_idx ON assigned_pr_reviewers (assigned_at) WHERE decision IS NULL;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS review_sla_minutes INTEGER CHECK (review_sla_minutes > 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE teams DROP COLUMN IF EXISTS review_sla_minutes;
+DROP INDEX IF EXISTS assigned_pr_reviewers_pending_idx;
+ALTER TABLE assigned_pr_reviewers
+    DROP COLUMN IF EXISTS decided_at,
+    DROP COLUMN IF EXISTS decision,
+    DROP COLUMN IF EXISTS assigned_at;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS reviewer_reassignments (
+    id BIGSERIAL PRIMARY KEY,
+    pr_id TEXT NOT NULL REFERENCES pullrequests (id) ON DELETE CASCADE,
+    old_reviewer_id TEXT NOT NULL REFERENCES users (id),
+    new_reviewer_id TEXT NOT NULL REFERENCES users (id),
+    assigned_at TIMESTAMPTZ NOT NULL,
+    reassigned_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS reviewer_reassignments_old_reviewer_idx ON reviewer_reassignments (old_reviewer_id, reassigned_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS reviewer_reassignments;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE assigned_pr_reviewers ADD COLUMN IF NOT EXISTS escalation_attempted_at TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE assigned_pr_reviewers DROP COLUMN IF EXISTS escalation_attempted_at;
+-- +goose StatementEnd
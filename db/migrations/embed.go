@@ -0,0 +1,7 @@
+// Package migrations встраивает SQL-миграции goose в бинарники сервера и prctl.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,40 @@
+package api
+
+import (
+	"avito-backend-intern-assignment/internal/pkg/requestctx"
+	"context"
+	"strconv"
+	"strings"
+)
+
+// ETag возвращает значение заголовка ETag для версии сущности.
+func ETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// WithIfMatch передаёт сервису версию сущности из заголовка If-Match. "*" и
+// пустой заголовок версию не проверяют; значение, не выданное как ETag, не
+// совпадает ни с одной версией (они начинаются с 1).
+func WithIfMatch(ctx context.Context, ifMatch *string, entityType string, entityID string) context.Context {
+	if ifMatch == nil {
+		return ctx
+	}
+	value := strings.TrimSpace(*ifMatch)
+	if value == "" || value == "*" {
+		return ctx
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+	if err != nil {
+		version = 0
+	}
+	return requestctx.WithIfMatch(ctx, entityType, entityID, version)
+}
+
+// VersionMismatch — тело ответа 412 на устаревший If-Match.
+func VersionMismatch() ErrorResponse {
+	var resp ErrorResponse
+	resp.Error.Code = VERSIONMISMATCH
+	resp.Error.Message = "entity version does not match If-Match"
+	return resp
+}
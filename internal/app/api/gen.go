@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -15,29 +16,181 @@ import (
 	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
 )
 
+// Defines values for AuditEventEntityType.
+const (
+	AuditEventEntityTypePullRequest AuditEventEntityType = "pull_request"
+	AuditEventEntityTypeTeam        AuditEventEntityType = "team"
+	AuditEventEntityTypeUser        AuditEventEntityType = "user"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	INTERNALSERVERERROR ErrorResponseErrorCode = "INTERNAL_SERVER_ERROR"
-	NOCANDIDATE         ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED         ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND            ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS            ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED            ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS          ErrorResponseErrorCode = "TEAM_EXISTS"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALSERVERERROR      ErrorResponseErrorCode = "INTERNAL_SERVER_ERROR"
+	INVALIDREQUEST           ErrorResponseErrorCode = "INVALID_REQUEST"
+	MEMBERCONFLICT           ErrorResponseErrorCode = "MEMBER_CONFLICT"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
+)
+
+// Defines values for NotificationMode.
+const (
+	DIGEST  NotificationMode = "DIGEST"
+	INSTANT NotificationMode = "INSTANT"
+	OFF     NotificationMode = "OFF"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewDecision.
+const (
+	APPROVED         ReviewDecision = "APPROVED"
+	CHANGESREQUESTED ReviewDecision = "CHANGES_REQUESTED"
+)
+
+// Defines values for SLAStatus.
+const (
+	AtRisk   SLAStatus = "at_risk"
+	Breached SLAStatus = "breached"
+	OnTime   SLAStatus = "on_time"
+)
+
+// Defines values for TeamImportChangeKind.
+const (
+	CreateTeam TeamImportChangeKind = "create_team"
+	CreateUser TeamImportChangeKind = "create_user"
+	MoveUser   TeamImportChangeKind = "move_user"
+	UpdateUser TeamImportChangeKind = "update_user"
+)
+
+// Defines values for VCSIdentityProvider.
+const (
+	VCSIdentityProviderGithub VCSIdentityProvider = "github"
+	VCSIdentityProviderGitlab VCSIdentityProvider = "gitlab"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDEAD      WebhookDeliveryStatus = "DEAD"
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+)
+
+// Defines values for GetAuditListParamsEntityType.
+const (
+	GetAuditListParamsEntityTypePullRequest GetAuditListParamsEntityType = "pull_request"
+	GetAuditListParamsEntityTypeTeam        GetAuditListParamsEntityType = "team"
+	GetAuditListParamsEntityTypeUser        GetAuditListParamsEntityType = "user"
+)
+
+// Defines values for GetStatsCycleTimeParamsGroupBy.
+const (
+	GetStatsCycleTimeParamsGroupByAuthor   GetStatsCycleTimeParamsGroupBy = "author"
+	GetStatsCycleTimeParamsGroupByReviewer GetStatsCycleTimeParamsGroupBy = "reviewer"
+	GetStatsCycleTimeParamsGroupByTeam     GetStatsCycleTimeParamsGroupBy = "team"
+)
+
+// Defines values for GetStatsCycleTimeParamsFormat.
+const (
+	Csv  GetStatsCycleTimeParamsFormat = "csv"
+	Json GetStatsCycleTimeParamsFormat = "json"
+)
+
+// Defines values for PostVcsIdentitiesLinkJSONBodyProvider.
+const (
+	PostVcsIdentitiesLinkJSONBodyProviderGithub PostVcsIdentitiesLinkJSONBodyProvider = "github"
+	PostVcsIdentitiesLinkJSONBodyProviderGitlab PostVcsIdentitiesLinkJSONBodyProvider = "gitlab"
+)
+
+// Defines values for GetWebhooksDeliveriesParamsStatus.
+const (
+	GetWebhooksDeliveriesParamsStatusDEAD      GetWebhooksDeliveriesParamsStatus = "DEAD"
+	GetWebhooksDeliveriesParamsStatusDELIVERED GetWebhooksDeliveriesParamsStatus = "DELIVERED"
+	GetWebhooksDeliveriesParamsStatusPENDING   GetWebhooksDeliveriesParamsStatus = "PENDING"
+)
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	// Action Тип операции, например pull_request.reviewer_reassigned
+	Action string `json:"action"`
+
+	// Actor Инициатор изменения (заголовок X-Actor)
+	Actor string `json:"actor"`
+
+	// After Снимок сущности после изменения
+	After *map[string]interface{} `json:"after"`
+
+	// Before Снимок сущности до изменения
+	Before     *map[string]interface{} `json:"before"`
+	EntityId   string                  `json:"entity_id"`
+	EntityType AuditEventEntityType    `json:"entity_type"`
+	Id         int64                   `json:"id"`
+	OccurredAt time.Time               `json:"occurred_at"`
+
+	// RequestId Идентификатор запроса (заголовок X-Request-Id)
+	RequestId string `json:"request_id"`
+}
+
+// AuditEventEntityType defines model for AuditEvent.EntityType.
+type AuditEventEntityType string
+
+// CycleTimeBucket defines model for CycleTimeBucket.
+type CycleTimeBucket struct {
+	CycleTime   Percentiles  `json:"cycle_time"`
+	FirstReview *Percentiles `json:"first_review,omitempty"`
+
+	// Key Имя команды, author_id или reviewer_id в зависимости от group_by
+	Key    string `json:"key"`
+	Merged int    `json:"merged"`
+
+	// WeekStart Понедельник недели слияния (UTC)
+	WeekStart time.Time `json:"week_start"`
+}
+
+// DirectoryReassignment defines model for DirectoryReassignment.
+type DirectoryReassignment struct {
+	// NewReviewerId Отсутствует в отчёте о расхождении, пока переназначение не выполнено
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// DirectorySyncReport defines model for DirectorySyncReport.
+type DirectorySyncReport struct {
+	Applied bool               `json:"applied"`
+	Changes []TeamImportChange `json:"changes"`
+
+	// Deactivated Активные пользователи, которых нет в каталоге
+	Deactivated []string `json:"deactivated"`
+
+	// InSync Сервис полностью совпадает с каталогом
+	InSync     bool                    `json:"in_sync"`
+	Reassigned []DirectoryReassignment `json:"reassigned"`
+	SyncedAt   time.Time               `json:"synced_at"`
+
+	// Unresolved Расхождения, которые нужно исправить в каталоге вручную
+	Unresolved []string `json:"unresolved"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -49,16 +202,71 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// FairnessMember defines model for FairnessMember.
+type FairnessMember struct {
+	// ActiveDays Дни активности участника в окне
+	ActiveDays  float64 `json:"active_days"`
+	Assignments int     `json:"assignments"`
+
+	// Deviation (share - ideal_share) / ideal_share, null при нулевой идеальной доле
+	Deviation *float64 `json:"deviation"`
+
+	// IdealShare Доля, пропорциональная дням активности
+	IdealShare float64 `json:"ideal_share"`
+	IsActive   bool    `json:"is_active"`
+
+	// Share Фактическая доля назначений команды
+	Share           float64 `json:"share"`
+	UserId          string  `json:"user_id"`
+	Username        string  `json:"username"`
+	WithinTolerance bool    `json:"within_tolerance"`
+}
+
+// FairnessReport defines model for FairnessReport.
+type FairnessReport struct {
+	From time.Time `json:"from"`
+
+	// Gini Коэффициент Джини нагрузки в день активности, 0 — идеально ровно
+	Gini float64 `json:"gini"`
+
+	// MaxMinRatio Отношение максимальной нагрузки в день к минимальной, null если минимум нулевой
+	MaxMinRatio *float64         `json:"max_min_ratio"`
+	Members     []FairnessMember `json:"members"`
+
+	// Outliers user_id участников вне допуска
+	Outliers         []string  `json:"outliers"`
+	TeamName         string    `json:"team_name"`
+	To               time.Time `json:"to"`
+	Tolerance        float64   `json:"tolerance"`
+	TotalAssignments int       `json:"total_assignments"`
+}
+
+// NotificationMode INSTANT — сразу, DIGEST — в ежедневной сводке, OFF — не уведомлять
+type NotificationMode string
+
+// Percentiles defines model for Percentiles.
+type Percentiles struct {
+	P50Seconds float64 `json:"p50_seconds"`
+	P90Seconds float64 `json:"p90_seconds"`
+	P99Seconds float64 `json:"p99_seconds"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Reviews Время назначения и решения каждого ревьювера
+	Reviews *[]ReviewAssignment `json:"reviews,omitempty"`
+
+	// SlaStatus Состояние самого давнего ожидающего решения назначения: at_risk — прошло не меньше 3/4 SLA команды, breached — SLA истёк. Только для OPEN PR.
+	SlaStatus *SLAStatus        `json:"sla_status,omitempty"`
+	Status    PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -66,21 +274,80 @@ type PullRequestStatus string
 
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
-	PullRequestId   string                 `json:"pull_request_id"`
-	PullRequestName string                 `json:"pull_request_name"`
-	Status          PullRequestShortStatus `json:"status"`
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// SlaStatus Состояние самого давнего ожидающего решения назначения: at_risk — прошло не меньше 3/4 SLA команды, breached — SLA истёк. Только для OPEN PR.
+	SlaStatus *SLAStatus             `json:"sla_status,omitempty"`
+	Status    PullRequestShortStatus `json:"status"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewAssignment defines model for ReviewAssignment.
+type ReviewAssignment struct {
+	AssignedAt time.Time       `json:"assigned_at"`
+	DecidedAt  *time.Time      `json:"decided_at"`
+	Decision   *ReviewDecision `json:"decision,omitempty"`
+	ReviewerId string          `json:"reviewer_id"`
+}
+
+// ReviewDecision defines model for ReviewDecision.
+type ReviewDecision string
+
+// ReviewerStats defines model for ReviewerStats.
+type ReviewerStats struct {
+	// MedianTimeToMergeSeconds Медиана времени от назначения до слияния
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds"`
+
+	// MergedReviewed PR, слитые за окно, где пользователь остался ревьювером
+	MergedReviewed int `json:"merged_reviewed"`
+
+	// OpenAssignments Текущие назначения на OPEN PR, не зависят от окна
+	OpenAssignments int `json:"open_assignments"`
+
+	// ReassignedAway Сколько раз ревьювер был снят с PR за окно
+	ReassignedAway int    `json:"reassigned_away"`
+	TeamName       string `json:"team_name"`
+
+	// TotalAssignments Назначения за окно, включая последующие переназначения
+	TotalAssignments int    `json:"total_assignments"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+}
+
+// SLAStatus Состояние самого давнего ожидающего решения назначения: at_risk — прошло не меньше 3/4 SLA команды, breached — SLA истёк. Только для OPEN PR.
+type SLAStatus string
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
 	TeamName string       `json:"team_name"`
 }
 
+// TeamImportChange defines model for TeamImportChange.
+type TeamImportChange struct {
+	// FromTeamName Прежняя команда пользователя, только для move_user
+	FromTeamName *string              `json:"from_team_name,omitempty"`
+	Kind         TeamImportChangeKind `json:"kind"`
+
+	// TeamName Команда, в которую попадёт пользователь или которая будет создана
+	TeamName string  `json:"team_name"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+// TeamImportChangeKind defines model for TeamImportChange.Kind.
+type TeamImportChangeKind string
+
+// TeamImportPlan defines model for TeamImportPlan.
+type TeamImportPlan struct {
+	// Applied false для dry_run, изменения не сохранены
+	Applied bool               `json:"applied"`
+	Changes []TeamImportChange `json:"changes"`
+}
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -88,6 +355,26 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamNotificationSettings defines model for TeamNotificationSettings.
+type TeamNotificationSettings struct {
+	// ChatWebhookUrl Incoming webhook Slack/Mattermost для канала команды
+	ChatWebhookUrl *string `json:"chat_webhook_url,omitempty"`
+
+	// Mode INSTANT — сразу, DIGEST — в ежедневной сводке, OFF — не уведомлять
+	Mode     NotificationMode `json:"mode"`
+	TeamName string           `json:"team_name"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds"`
+	MergedReviewed           int      `json:"merged_reviewed"`
+	OpenAssignments          int      `json:"open_assignments"`
+	ReassignedAway           int      `json:"reassigned_away"`
+	TeamName                 string   `json:"team_name"`
+	TotalAssignments         int      `json:"total_assignments"`
+}
+
 // User defines model for User.
 type User struct {
 	IsActive bool   `json:"is_active"`
@@ -96,12 +383,116 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserNotificationPreferences defines model for UserNotificationPreferences.
+type UserNotificationPreferences struct {
+	// Email Адрес для писем, без него email-уведомления не отправляются
+	Email *string `json:"email,omitempty"`
+
+	// Mode INSTANT — сразу, DIGEST — в ежедневной сводке, OFF — не уведомлять
+	Mode   NotificationMode `json:"mode"`
+	UserId string           `json:"user_id"`
+}
+
+// VCSIdentity defines model for VCSIdentity.
+type VCSIdentity struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Login Логин пользователя в GitHub/GitLab
+	Login    string              `json:"login"`
+	Provider VCSIdentityProvider `json:"provider"`
+	UserId   string              `json:"user_id"`
+}
+
+// VCSIdentityProvider defines model for VCSIdentity.Provider.
+type VCSIdentityProvider string
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts       int                   `json:"attempts"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at"`
+	EventId        int64                 `json:"event_id"`
+	EventType      string                `json:"event_type"`
+	Id             int64                 `json:"id"`
+	LastError      string                `json:"last_error"`
+	NextAttemptAt  time.Time             `json:"next_attempt_at"`
+	ResponseStatus *int                  `json:"response_status"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	SubscriptionId int64                 `json:"subscription_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventTypes Типы событий, например pull_request.reviewer_assigned
+	EventTypes []string `json:"event_types"`
+	Id         int64    `json:"id"`
+
+	// Secret Секрет для HMAC-подписи, возвращается только при создании
+	Secret   *string `json:"secret,omitempty"`
+	TeamName string  `json:"team_name"`
+	Url      string  `json:"url"`
+}
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// StatsFrom defines model for StatsFrom.
+type StatsFrom = time.Time
+
+// StatsTo defines model for StatsTo.
+type StatsTo = time.Time
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetAuditListParams defines parameters for GetAuditList.
+type GetAuditListParams struct {
+	Actor      *string                       `form:"actor,omitempty" json:"actor,omitempty"`
+	Action     *string                       `form:"action,omitempty" json:"action,omitempty"`
+	EntityType *GetAuditListParamsEntityType `form:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityId   *string                       `form:"entity_id,omitempty" json:"entity_id,omitempty"`
+	RequestId  *string                       `form:"request_id,omitempty" json:"request_id,omitempty"`
+
+	// From Нижняя граница occurred_at (включительно)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Верхняя граница occurred_at (не включительно)
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit  *int       `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int       `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAuditListParamsEntityType defines parameters for GetAuditList.
+type GetAuditListParamsEntityType string
+
+// PostDirectorySyncParams defines parameters for PostDirectorySync.
+type PostDirectorySyncParams struct {
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// GetNotificationsTeamSettingsParams defines parameters for GetNotificationsTeamSettings.
+type GetNotificationsTeamSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetNotificationsUserPreferencesParams defines parameters for GetNotificationsUserPreferences.
+type GetNotificationsUserPreferencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
 	AuthorId        string `json:"author_id"`
@@ -109,23 +500,147 @@ type PostPullRequestCreateJSONBody struct {
 	PullRequestName string `json:"pull_request_name"`
 }
 
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение IDEMPOTENCY_TTL возвращает сохранённый ответ с заголовком Idempotent-Replayed: true, не выполняя операцию повторно. Пока первый запрос выполняется, повтор получает 409 IDEMPOTENCY_KEY_IN_PROGRESS. Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+}
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IfMatch ETag сущности из предыдущего ответа, например "3". Если сущность с тех пор изменилась, операция не выполняется и возвращается 412 VERSION_MISMATCH. Без заголовка или со значением "*" версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение IDEMPOTENCY_TTL возвращает сохранённый ответ с заголовком Idempotent-Replayed: true, не выполняя операцию повторно. Пока первый запрос выполняется, повтор получает 409 IDEMPOTENCY_KEY_IN_PROGRESS. Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag сущности из предыдущего ответа, например "3". Если сущность с тех пор изменилась, операция не выполняется и возвращается 412 VERSION_MISMATCH. Без заголовка или со значением "*" версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	Decision      ReviewDecision `json:"decision"`
+	PullRequestId string         `json:"pull_request_id"`
+	ReviewerId    string         `json:"reviewer_id"`
+}
+
+// PostPullRequestReviewParams defines parameters for PostPullRequestReview.
+type PostPullRequestReviewParams struct {
+	// IfMatch ETag сущности из предыдущего ответа, например "3". Если сущность с тех пор изменилась, операция не выполняется и возвращается 412 VERSION_MISMATCH. Без заголовка или со значением "*" версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStatsCycleTimeParams defines parameters for GetStatsCycleTime.
+type GetStatsCycleTimeParams struct {
+	GroupBy *GetStatsCycleTimeParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+	Format  *GetStatsCycleTimeParamsFormat  `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Начало окна (включительно), по умолчанию to минус STATS_WINDOW
+	From *StatsFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включительно), по умолчанию текущий момент
+	To *StatsTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsCycleTimeParamsGroupBy defines parameters for GetStatsCycleTime.
+type GetStatsCycleTimeParamsGroupBy string
+
+// GetStatsCycleTimeParamsFormat defines parameters for GetStatsCycleTime.
+type GetStatsCycleTimeParamsFormat string
+
+// GetStatsFairnessParams defines parameters for GetStatsFairness.
+type GetStatsFairnessParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// From Начало окна (включительно), по умолчанию to минус STATS_WINDOW
+	From *StatsFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включительно), по умолчанию текущий момент
+	To *StatsTo `form:"to,omitempty" json:"to,omitempty"`
+
+	// Tolerance Допустимое относительное отклонение доли, по умолчанию FAIRNESS_TOLERANCE
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty"`
+}
+
+// GetStatsReviewersParams defines parameters for GetStatsReviewers.
+type GetStatsReviewersParams struct {
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна (включительно), по умолчанию to минус STATS_WINDOW
+	From *StatsFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включительно), по умолчанию текущий момент
+	To *StatsTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatsTeamsParams defines parameters for GetStatsTeams.
+type GetStatsTeamsParams struct {
+	// From Начало окна (включительно), по умолчанию to минус STATS_WINDOW
+	From *StatsFrom `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна (не включительно), по умолчанию текущий момент
+	To *StatsTo `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение IDEMPOTENCY_TTL возвращает сохранённый ответ с заголовком Idempotent-Replayed: true, не выполняя операцию повторно. Пока первый запрос выполняется, повтор получает 409 IDEMPOTENCY_KEY_IN_PROGRESS. Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag сущности из предыдущего ответа, например "3". Если сущность с тех пор изменилась, операция не выполняется и возвращается 412 VERSION_MISMATCH. Без заголовка или со значением "*" версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamImportParams defines parameters for PostTeamImport.
+type PostTeamImportParams struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostTeamSetReviewSlaJSONBody defines parameters for PostTeamSetReviewSla.
+type PostTeamSetReviewSlaJSONBody struct {
+	ReviewSlaMinutes int    `json:"review_sla_minutes"`
+	TeamName         string `json:"team_name"`
+}
+
+// PostTeamSetReviewSlaParams defines parameters for PostTeamSetReviewSla.
+type PostTeamSetReviewSlaParams struct {
+	// IfMatch ETag сущности из предыдущего ответа, например "3". Если сущность с тех пор изменилась, операция не выполняется и возвращается 412 VERSION_MISMATCH. Без заголовка или со значением "*" версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetUsersGetParams defines parameters for GetUsersGet.
+type GetUsersGetParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
@@ -138,6 +653,68 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetIsActiveParams defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повтор запроса с тем же ключом и телом в течение IDEMPOTENCY_TTL возвращает сохранённый ответ с заголовком Idempotent-Replayed: true, не выполняя операцию повторно. Пока первый запрос выполняется, повтор получает 409 IDEMPOTENCY_KEY_IN_PROGRESS. Ответы 5xx не сохраняются.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag сущности из предыдущего ответа, например "3". Если сущность с тех пор изменилась, операция не выполняется и возвращается 412 VERSION_MISMATCH. Без заголовка или со значением "*" версия не проверяется.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostVcsIdentitiesLinkJSONBody defines parameters for PostVcsIdentitiesLink.
+type PostVcsIdentitiesLinkJSONBody struct {
+	Login    string                                `json:"login"`
+	Provider PostVcsIdentitiesLinkJSONBodyProvider `json:"provider"`
+	UserId   string                                `json:"user_id"`
+}
+
+// PostVcsIdentitiesLinkJSONBodyProvider defines parameters for PostVcsIdentitiesLink.
+type PostVcsIdentitiesLinkJSONBodyProvider string
+
+// GetVcsIdentitiesListParams defines parameters for GetVcsIdentitiesList.
+type GetVcsIdentitiesListParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// GetWebhooksDeliveriesParams defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParams struct {
+	SubscriptionId int64                              `form:"subscription_id" json:"subscription_id"`
+	Status         *GetWebhooksDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit          *int                               `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetWebhooksDeliveriesParamsStatus defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParamsStatus string
+
+// GetWebhooksListParams defines parameters for GetWebhooksList.
+type GetWebhooksListParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostWebhooksSubscribeJSONBody defines parameters for PostWebhooksSubscribe.
+type PostWebhooksSubscribeJSONBody struct {
+	EventTypes []string `json:"event_types"`
+
+	// Secret Если не передан, будет сгенерирован
+	Secret   *string `json:"secret,omitempty"`
+	TeamName string  `json:"team_name"`
+	Url      string  `json:"url"`
+}
+
+// PostWebhooksUnsubscribeJSONBody defines parameters for PostWebhooksUnsubscribe.
+type PostWebhooksUnsubscribeJSONBody struct {
+	SubscriptionId int64 `json:"subscription_id"`
+}
+
+// PostNotificationsTeamSettingsJSONRequestBody defines body for PostNotificationsTeamSettings for application/json ContentType.
+type PostNotificationsTeamSettingsJSONRequestBody = TeamNotificationSettings
+
+// PostNotificationsUserPreferencesJSONRequestBody defines body for PostNotificationsUserPreferences for application/json ContentType.
+type PostNotificationsUserPreferencesJSONRequestBody = UserNotificationPreferences
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -147,62 +724,221 @@ type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamSetReviewSlaJSONRequestBody defines body for PostTeamSetReviewSla for application/json ContentType.
+type PostTeamSetReviewSlaJSONRequestBody PostTeamSetReviewSlaJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostVcsIdentitiesLinkJSONRequestBody defines body for PostVcsIdentitiesLink for application/json ContentType.
+type PostVcsIdentitiesLinkJSONRequestBody PostVcsIdentitiesLinkJSONBody
+
+// PostWebhooksSubscribeJSONRequestBody defines body for PostWebhooksSubscribe for application/json ContentType.
+type PostWebhooksSubscribeJSONRequestBody PostWebhooksSubscribeJSONBody
+
+// PostWebhooksUnsubscribeJSONRequestBody defines body for PostWebhooksUnsubscribe for application/json ContentType.
+type PostWebhooksUnsubscribeJSONRequestBody PostWebhooksUnsubscribeJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получить журнал изменяющих операций (новые события первыми)
+	// (GET /audit/list)
+	GetAuditList(w http.ResponseWriter, r *http.Request, params GetAuditListParams)
+	// Расхождение команд и пользователей с SCIM-каталогом без применения изменений
+	// (GET /directory/drift)
+	GetDirectoryDrift(w http.ResponseWriter, r *http.Request)
+	// Немедленно синхронизировать команды и пользователей с SCIM-каталогом
+	// (POST /directory/sync)
+	PostDirectorySync(w http.ResponseWriter, r *http.Request, params PostDirectorySyncParams)
+	// Получить настройки уведомлений команды
+	// (GET /notifications/team/settings)
+	GetNotificationsTeamSettings(w http.ResponseWriter, r *http.Request, params GetNotificationsTeamSettingsParams)
+	// Задать чат и режим уведомлений команды
+	// (POST /notifications/team/settings)
+	PostNotificationsTeamSettings(w http.ResponseWriter, r *http.Request)
+	// Получить настройки уведомлений пользователя
+	// (GET /notifications/user/preferences)
+	GetNotificationsUserPreferences(w http.ResponseWriter, r *http.Request, params GetNotificationsUserPreferencesParams)
+	// Задать email и режим уведомлений пользователя
+	// (POST /notifications/user/preferences)
+	PostNotificationsUserPreferences(w http.ResponseWriter, r *http.Request)
 	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 	// (POST /pullRequest/create)
-	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams)
+	// Получить PR с назначениями ревьюверов
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
-	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
+	// Зафиксировать решение ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(w http.ResponseWriter, r *http.Request, params PostPullRequestReviewParams)
+	// Перцентили времени цикла слитых PR по неделям
+	// (GET /stats/cycleTime)
+	GetStatsCycleTime(w http.ResponseWriter, r *http.Request, params GetStatsCycleTimeParams)
+	// Равномерность распределения ревью в команде за окно [from, to)
+	// (GET /stats/fairness)
+	GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams)
+	// Статистика ревью по пользователям за окно [from, to)
+	// (GET /stats/reviewers)
+	GetStatsReviewers(w http.ResponseWriter, r *http.Request, params GetStatsReviewersParams)
+	// Статистика ревью по командам за окно [from, to)
+	// (GET /stats/teams)
+	GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Массовый импорт команд и участников из CSV или YAML одной транзакцией
+	// (POST /team/import)
+	PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams)
+	// Задать SLA ревью команды, после которого ревьювер переназначается
+	// (POST /team/setReviewSla)
+	PostTeamSetReviewSla(w http.ResponseWriter, r *http.Request, params PostTeamSetReviewSlaParams)
+	// Получить пользователя
+	// (GET /users/get)
+	GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
-	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams)
+	// Сопоставить логин GitHub/GitLab пользователю сервиса (перезаписывает существующую связь)
+	// (POST /vcs/identities/link)
+	PostVcsIdentitiesLink(w http.ResponseWriter, r *http.Request)
+	// Получить логины GitHub/GitLab пользователя
+	// (GET /vcs/identities/list)
+	GetVcsIdentitiesList(w http.ResponseWriter, r *http.Request, params GetVcsIdentitiesListParams)
+	// Журнал доставок подписки (новые первыми)
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhooksDeliveriesParams)
+	// Получить подписки команды
+	// (GET /webhooks/list)
+	GetWebhooksList(w http.ResponseWriter, r *http.Request, params GetWebhooksListParams)
+	// Подписать команду на исходящие вебхуки (тело подписывается заголовком X-Signature, HMAC-SHA256)
+	// (POST /webhooks/subscribe)
+	PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request)
+	// Удалить подписку вместе с журналом её доставок
+	// (POST /webhooks/unsubscribe)
+	PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Получить журнал изменяющих операций (новые события первыми)
+// (GET /audit/list)
+func (_ Unimplemented) GetAuditList(w http.ResponseWriter, r *http.Request, params GetAuditListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Расхождение команд и пользователей с SCIM-каталогом без применения изменений
+// (GET /directory/drift)
+func (_ Unimplemented) GetDirectoryDrift(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Немедленно синхронизировать команды и пользователей с SCIM-каталогом
+// (POST /directory/sync)
+func (_ Unimplemented) PostDirectorySync(w http.ResponseWriter, r *http.Request, params PostDirectorySyncParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить настройки уведомлений команды
+// (GET /notifications/team/settings)
+func (_ Unimplemented) GetNotificationsTeamSettings(w http.ResponseWriter, r *http.Request, params GetNotificationsTeamSettingsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать чат и режим уведомлений команды
+// (POST /notifications/team/settings)
+func (_ Unimplemented) PostNotificationsTeamSettings(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить настройки уведомлений пользователя
+// (GET /notifications/user/preferences)
+func (_ Unimplemented) GetNotificationsUserPreferences(w http.ResponseWriter, r *http.Request, params GetNotificationsUserPreferencesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать email и режим уведомлений пользователя
+// (POST /notifications/user/preferences)
+func (_ Unimplemented) PostNotificationsUserPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создать PR и автоматически назначить до 2 ревьюверов из команды автора
 // (POST /pullRequest/create)
-func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR с назначениями ревьюверов
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Пометить PR как MERGED (идемпотентная операция)
 // (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Переназначить конкретного ревьювера на другого из его команды
 // (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Зафиксировать решение ревьювера по PR
+// (POST /pullRequest/review)
+func (_ Unimplemented) PostPullRequestReview(w http.ResponseWriter, r *http.Request, params PostPullRequestReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перцентили времени цикла слитых PR по неделям
+// (GET /stats/cycleTime)
+func (_ Unimplemented) GetStatsCycleTime(w http.ResponseWriter, r *http.Request, params GetStatsCycleTimeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Равномерность распределения ревью в команде за окно [from, to)
+// (GET /stats/fairness)
+func (_ Unimplemented) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика ревью по пользователям за окно [from, to)
+// (GET /stats/reviewers)
+func (_ Unimplemented) GetStatsReviewers(w http.ResponseWriter, r *http.Request, params GetStatsReviewersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика ревью по командам за окно [from, to)
+// (GET /stats/teams)
+func (_ Unimplemented) GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Создать команду с участниками (создаёт/обновляет пользователей)
 // (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -212,6 +948,24 @@ func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Массовый импорт команд и участников из CSV или YAML одной транзакцией
+// (POST /team/import)
+func (_ Unimplemented) PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать SLA ревью команды, после которого ревьювер переназначается
+// (POST /team/setReviewSla)
+func (_ Unimplemented) PostTeamSetReviewSla(w http.ResponseWriter, r *http.Request, params PostTeamSetReviewSlaParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить пользователя
+// (GET /users/get)
+func (_ Unimplemented) GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получить PR'ы, где пользователь назначен ревьювером
 // (GET /users/getReview)
 func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
@@ -220,38 +974,137 @@ func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request,
 
 // Установить флаг активности пользователя
 // (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Сопоставить логин GitHub/GitLab пользователю сервиса (перезаписывает существующую связь)
+// (POST /vcs/identities/link)
+func (_ Unimplemented) PostVcsIdentitiesLink(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
+// Получить логины GitHub/GitLab пользователя
+// (GET /vcs/identities/list)
+func (_ Unimplemented) GetVcsIdentitiesList(w http.ResponseWriter, r *http.Request, params GetVcsIdentitiesListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// Журнал доставок подписки (новые первыми)
+// (GET /webhooks/deliveries)
+func (_ Unimplemented) GetWebhooksDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhooksDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
-	}))
+// Получить подписки команды
+// (GET /webhooks/list)
+func (_ Unimplemented) GetWebhooksList(w http.ResponseWriter, r *http.Request, params GetWebhooksListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// Подписать команду на исходящие вебхуки (тело подписывается заголовком X-Signature, HMAC-SHA256)
+// (POST /webhooks/subscribe)
+func (_ Unimplemented) PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
 
-	handler.ServeHTTP(w, r)
+// Удалить подписку вместе с журналом её доставок
+// (POST /webhooks/unsubscribe)
+func (_ Unimplemented) PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAuditList operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditListParams
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_type", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entity_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entity_id", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "request_id", r.URL.Query(), &params.RequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.GetAuditList(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -261,11 +1114,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// GetDirectoryDrift operation middleware
+func (siw *ServerInterfaceWrapper) GetDirectoryDrift(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.GetDirectoryDrift(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -275,11 +1128,24 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostDirectorySync operation middleware
+func (siw *ServerInterfaceWrapper) PostDirectorySync(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostDirectorySyncParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostDirectorySync(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -289,13 +1155,13 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+// GetNotificationsTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationsTeamSettings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetNotificationsTeamSettingsParams
 
 	// ------------- Required query parameter "team_name" -------------
 
@@ -313,7 +1179,7 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTeamGet(w, r, params)
+		siw.Handler.GetNotificationsTeamSettings(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -323,13 +1189,27 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+// PostNotificationsTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsTeamSettings(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostNotificationsTeamSettings(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationsUserPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationsUserPreferences(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
+	var params GetNotificationsUserPreferencesParams
 
 	// ------------- Required query parameter "user_id" -------------
 
@@ -347,7 +1227,7 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUsersGetReview(w, r, params)
+		siw.Handler.GetNotificationsUserPreferences(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -357,11 +1237,11 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+// PostNotificationsUserPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsUserPreferences(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostUsersSetIsActive(w, r)
+		siw.Handler.PostNotificationsUserPreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -371,473 +1251,3146 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	headers := r.Header
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-type RequiredParamError struct {
-	ParamName string
-}
+		params.IdempotencyKey = &IdempotencyKey
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r, params)
+	}))
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r)
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	var err error
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	// ------------- Required query parameter "pull_request_id" -------------
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGet(w, r, params)
+	}))
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	var err error
 
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-	})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
 
-	return r
-}
+	headers := r.Header
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+		params.IfMatch = &IfMatch
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+	headers := r.Header
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
 
-type PostPullRequestCreate500JSONResponse ErrorResponse
+		params.IdempotencyKey = &IdempotencyKey
 
-func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
-}
+		params.IfMatch = &IfMatch
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	}
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReviewParams
 
-type PostPullRequestMerge500JSONResponse ErrorResponse
+	headers := r.Header
 
-func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+		params.IfMatch = &IfMatch
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
-}
+	}
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetStatsCycleTime operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsCycleTime(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsCycleTimeParams
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "group_by" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+	// ------------- Optional query parameter "format" -------------
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "from" -------------
 
-type PostPullRequestReassign500JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "to" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsCycleTime(w, r, params)
+	}))
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+	handler.ServeHTTP(w, r)
 }
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetStatsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsFairness(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type PostTeamAdd400JSONResponse ErrorResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsFairnessParams
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Required query parameter "team_name" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
 
-type PostTeamAdd500JSONResponse ErrorResponse
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
 
-func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "from" -------------
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tolerance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tolerance", r.URL.Query(), &params.Tolerance)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tolerance", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsFairness(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetTeamGet200JSONResponse Team
+// GetStatsReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsReviewers(w http.ResponseWriter, r *http.Request) {
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsReviewersParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsReviewers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetTeamGet404JSONResponse ErrorResponse
+// GetStatsTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsTeams(w http.ResponseWriter, r *http.Request) {
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatsTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamImportParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetReviewSla operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewSla(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSetReviewSlaParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetReviewSla(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGet operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetIsActiveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Idempotency-Key", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Idempotency-Key", Err: err})
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostVcsIdentitiesLink operation middleware
+func (siw *ServerInterfaceWrapper) PostVcsIdentitiesLink(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostVcsIdentitiesLink(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVcsIdentitiesList operation middleware
+func (siw *ServerInterfaceWrapper) GetVcsIdentitiesList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVcsIdentitiesListParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := r.URL.Query().Get("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVcsIdentitiesList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeliveriesParams
+
+	// ------------- Required query parameter "subscription_id" -------------
+
+	if paramValue := r.URL.Query().Get("subscription_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "subscription_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "subscription_id", r.URL.Query(), &params.SubscriptionId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscription_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksDeliveries(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksListParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := r.URL.Query().Get("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksSubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksSubscribe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksUnsubscribe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit/list", wrapper.GetAuditList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/directory/drift", wrapper.GetDirectoryDrift)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/directory/sync", wrapper.PostDirectorySync)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/team/settings", wrapper.GetNotificationsTeamSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/team/settings", wrapper.PostNotificationsTeamSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/user/preferences", wrapper.GetNotificationsUserPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/user/preferences", wrapper.PostNotificationsUserPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/cycleTime", wrapper.GetStatsCycleTime)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/fairness", wrapper.GetStatsFairness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/reviewers", wrapper.GetStatsReviewers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/stats/teams", wrapper.GetStatsTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/import", wrapper.PostTeamImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setReviewSla", wrapper.PostTeamSetReviewSla)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/get", wrapper.GetUsersGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vcs/identities/link", wrapper.PostVcsIdentitiesLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vcs/identities/list", wrapper.GetVcsIdentitiesList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/deliveries", wrapper.GetWebhooksDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/list", wrapper.GetWebhooksList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/subscribe", wrapper.PostWebhooksSubscribe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/unsubscribe", wrapper.PostWebhooksUnsubscribe)
+	})
+
+	return r
+}
+
+type GetAuditListRequestObject struct {
+	Params GetAuditListParams
+}
+
+type GetAuditListResponseObject interface {
+	VisitGetAuditListResponse(w http.ResponseWriter) error
+}
+
+type GetAuditList200JSONResponse struct {
+	Events []AuditEvent `json:"events"`
+}
+
+func (response GetAuditList200JSONResponse) VisitGetAuditListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditList400JSONResponse ErrorResponse
+
+func (response GetAuditList400JSONResponse) VisitGetAuditListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditList500JSONResponse ErrorResponse
+
+func (response GetAuditList500JSONResponse) VisitGetAuditListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDirectoryDriftRequestObject struct {
+}
+
+type GetDirectoryDriftResponseObject interface {
+	VisitGetDirectoryDriftResponse(w http.ResponseWriter) error
+}
+
+type GetDirectoryDrift200JSONResponse DirectorySyncReport
+
+func (response GetDirectoryDrift200JSONResponse) VisitGetDirectoryDriftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDirectoryDrift404JSONResponse ErrorResponse
+
+func (response GetDirectoryDrift404JSONResponse) VisitGetDirectoryDriftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDirectoryDrift500JSONResponse ErrorResponse
+
+func (response GetDirectoryDrift500JSONResponse) VisitGetDirectoryDriftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDirectorySyncRequestObject struct {
+	Params PostDirectorySyncParams
+}
+
+type PostDirectorySyncResponseObject interface {
+	VisitPostDirectorySyncResponse(w http.ResponseWriter) error
+}
+
+type PostDirectorySync200JSONResponse DirectorySyncReport
+
+func (response PostDirectorySync200JSONResponse) VisitPostDirectorySyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDirectorySync404JSONResponse ErrorResponse
+
+func (response PostDirectorySync404JSONResponse) VisitPostDirectorySyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDirectorySync500JSONResponse ErrorResponse
+
+func (response PostDirectorySync500JSONResponse) VisitPostDirectorySyncResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationsTeamSettingsRequestObject struct {
+	Params GetNotificationsTeamSettingsParams
+}
+
+type GetNotificationsTeamSettingsResponseObject interface {
+	VisitGetNotificationsTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationsTeamSettings200JSONResponse struct {
+	Settings TeamNotificationSettings `json:"settings"`
+}
+
+func (response GetNotificationsTeamSettings200JSONResponse) VisitGetNotificationsTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationsTeamSettings404JSONResponse ErrorResponse
+
+func (response GetNotificationsTeamSettings404JSONResponse) VisitGetNotificationsTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationsTeamSettings500JSONResponse ErrorResponse
+
+func (response GetNotificationsTeamSettings500JSONResponse) VisitGetNotificationsTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsTeamSettingsRequestObject struct {
+	Body *PostNotificationsTeamSettingsJSONRequestBody
+}
+
+type PostNotificationsTeamSettingsResponseObject interface {
+	VisitPostNotificationsTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type PostNotificationsTeamSettings200JSONResponse struct {
+	Settings TeamNotificationSettings `json:"settings"`
+}
+
+func (response PostNotificationsTeamSettings200JSONResponse) VisitPostNotificationsTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsTeamSettings400JSONResponse ErrorResponse
+
+func (response PostNotificationsTeamSettings400JSONResponse) VisitPostNotificationsTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsTeamSettings404JSONResponse ErrorResponse
+
+func (response PostNotificationsTeamSettings404JSONResponse) VisitPostNotificationsTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsTeamSettings500JSONResponse ErrorResponse
+
+func (response PostNotificationsTeamSettings500JSONResponse) VisitPostNotificationsTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationsUserPreferencesRequestObject struct {
+	Params GetNotificationsUserPreferencesParams
+}
+
+type GetNotificationsUserPreferencesResponseObject interface {
+	VisitGetNotificationsUserPreferencesResponse(w http.ResponseWriter) error
+}
+
+type GetNotificationsUserPreferences200JSONResponse struct {
+	Preferences UserNotificationPreferences `json:"preferences"`
+}
+
+func (response GetNotificationsUserPreferences200JSONResponse) VisitGetNotificationsUserPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationsUserPreferences404JSONResponse ErrorResponse
+
+func (response GetNotificationsUserPreferences404JSONResponse) VisitGetNotificationsUserPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNotificationsUserPreferences500JSONResponse ErrorResponse
+
+func (response GetNotificationsUserPreferences500JSONResponse) VisitGetNotificationsUserPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsUserPreferencesRequestObject struct {
+	Body *PostNotificationsUserPreferencesJSONRequestBody
+}
+
+type PostNotificationsUserPreferencesResponseObject interface {
+	VisitPostNotificationsUserPreferencesResponse(w http.ResponseWriter) error
+}
+
+type PostNotificationsUserPreferences200JSONResponse struct {
+	Preferences UserNotificationPreferences `json:"preferences"`
+}
+
+func (response PostNotificationsUserPreferences200JSONResponse) VisitPostNotificationsUserPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsUserPreferences400JSONResponse ErrorResponse
+
+func (response PostNotificationsUserPreferences400JSONResponse) VisitPostNotificationsUserPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsUserPreferences404JSONResponse ErrorResponse
+
+func (response PostNotificationsUserPreferences404JSONResponse) VisitPostNotificationsUserPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostNotificationsUserPreferences500JSONResponse ErrorResponse
+
+func (response PostNotificationsUserPreferences500JSONResponse) VisitPostNotificationsUserPreferencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Params PostPullRequestCreateParams
+	Body   *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate422JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate422JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate500JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGetRequestObject struct {
+	Params GetPullRequestGetParams
+}
+
+type GetPullRequestGetResponseObject interface {
+	VisitGetPullRequestGetResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetPullRequestGet200JSONResponse struct {
+	Body    PullRequest
+	Headers GetPullRequestGet200ResponseHeaders
+}
+
+func (response GetPullRequestGet200JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequestGet404JSONResponse ErrorResponse
+
+func (response GetPullRequestGet404JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet500JSONResponse ErrorResponse
+
+func (response GetPullRequestGet500JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Params PostPullRequestMergeParams
+	Body   *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers PostPullRequestMerge200ResponseHeaders
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge412JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge412JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge500JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Params PostPullRequestReassignParams
+	Body   *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	Headers PostPullRequestReassign200ResponseHeaders
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign412JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign412JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign422JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign422JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign500JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewRequestObject struct {
+	Params PostPullRequestReviewParams
+	Body   *PostPullRequestReviewJSONRequestBody
+}
+
+type PostPullRequestReviewResponseObject interface {
+	VisitPostPullRequestReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReview200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestReview200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestReview200ResponseHeaders
+}
+
+func (response PostPullRequestReview200JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestReview400JSONResponse ErrorResponse
+
+func (response PostPullRequestReview400JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestReview404JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestReview409JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview412JSONResponse ErrorResponse
+
+func (response PostPullRequestReview412JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview500JSONResponse ErrorResponse
+
+func (response PostPullRequestReview500JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsCycleTimeRequestObject struct {
+	Params GetStatsCycleTimeParams
+}
+
+type GetStatsCycleTimeResponseObject interface {
+	VisitGetStatsCycleTimeResponse(w http.ResponseWriter) error
+}
+
+type GetStatsCycleTime200JSONResponse struct {
+	Buckets []CycleTimeBucket `json:"buckets"`
+	From    time.Time         `json:"from"`
+	GroupBy string            `json:"group_by"`
+	To      time.Time         `json:"to"`
+}
+
+func (response GetStatsCycleTime200JSONResponse) VisitGetStatsCycleTimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsCycleTime200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetStatsCycleTime200TextcsvResponse) VisitGetStatsCycleTimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetStatsCycleTime400JSONResponse ErrorResponse
+
+func (response GetStatsCycleTime400JSONResponse) VisitGetStatsCycleTimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsCycleTime500JSONResponse ErrorResponse
+
+func (response GetStatsCycleTime500JSONResponse) VisitGetStatsCycleTimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairnessRequestObject struct {
+	Params GetStatsFairnessParams
+}
+
+type GetStatsFairnessResponseObject interface {
+	VisitGetStatsFairnessResponse(w http.ResponseWriter) error
+}
+
+type GetStatsFairness200JSONResponse struct {
+	Report FairnessReport `json:"report"`
+}
+
+func (response GetStatsFairness200JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness400JSONResponse ErrorResponse
+
+func (response GetStatsFairness400JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness404JSONResponse ErrorResponse
+
+func (response GetStatsFairness404JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsFairness500JSONResponse ErrorResponse
+
+func (response GetStatsFairness500JSONResponse) VisitGetStatsFairnessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewersRequestObject struct {
+	Params GetStatsReviewersParams
+}
+
+type GetStatsReviewersResponseObject interface {
+	VisitGetStatsReviewersResponse(w http.ResponseWriter) error
+}
+
+type GetStatsReviewers200JSONResponse struct {
+	From      time.Time       `json:"from"`
+	Reviewers []ReviewerStats `json:"reviewers"`
+	To        time.Time       `json:"to"`
+}
+
+func (response GetStatsReviewers200JSONResponse) VisitGetStatsReviewersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewers400JSONResponse ErrorResponse
+
+func (response GetStatsReviewers400JSONResponse) VisitGetStatsReviewersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsReviewers500JSONResponse ErrorResponse
+
+func (response GetStatsReviewers500JSONResponse) VisitGetStatsReviewersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeamsRequestObject struct {
+	Params GetStatsTeamsParams
+}
+
+type GetStatsTeamsResponseObject interface {
+	VisitGetStatsTeamsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsTeams200JSONResponse struct {
+	From  time.Time   `json:"from"`
+	Teams []TeamStats `json:"teams"`
+	To    time.Time   `json:"to"`
+}
+
+func (response GetStatsTeams200JSONResponse) VisitGetStatsTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeams400JSONResponse ErrorResponse
+
+func (response GetStatsTeams400JSONResponse) VisitGetStatsTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeams500JSONResponse ErrorResponse
+
+func (response GetStatsTeams500JSONResponse) VisitGetStatsTeamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Params PostTeamAddParams
+	Body   *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201ResponseHeaders struct {
+	ETag string
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Body struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	Headers PostTeamAdd201ResponseHeaders
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd409JSONResponse ErrorResponse
+
+func (response PostTeamAdd409JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd412JSONResponse ErrorResponse
+
+func (response PostTeamAdd412JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd422JSONResponse ErrorResponse
+
+func (response PostTeamAdd422JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd500JSONResponse ErrorResponse
+
+func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTeamGet200JSONResponse struct {
+	Body    Team
+	Headers GetTeamGet200ResponseHeaders
+}
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet500JSONResponse ErrorResponse
+
+func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamImportRequestObject struct {
+	Params      PostTeamImportParams
+	ContentType string
+	Body        io.Reader
+}
+
+type PostTeamImportResponseObject interface {
+	VisitPostTeamImportResponse(w http.ResponseWriter) error
+}
+
+type PostTeamImport200JSONResponse TeamImportPlan
+
+func (response PostTeamImport200JSONResponse) VisitPostTeamImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamImport400JSONResponse ErrorResponse
+
+func (response PostTeamImport400JSONResponse) VisitPostTeamImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamImport409JSONResponse ErrorResponse
+
+func (response PostTeamImport409JSONResponse) VisitPostTeamImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamImport500JSONResponse ErrorResponse
+
+func (response PostTeamImport500JSONResponse) VisitPostTeamImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSlaRequestObject struct {
+	Params PostTeamSetReviewSlaParams
+	Body   *PostTeamSetReviewSlaJSONRequestBody
+}
+
+type PostTeamSetReviewSlaResponseObject interface {
+	VisitPostTeamSetReviewSlaResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetReviewSla200Response struct {
+}
+
+func (response PostTeamSetReviewSla200Response) VisitPostTeamSetReviewSlaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostTeamSetReviewSla400JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewSla400JSONResponse) VisitPostTeamSetReviewSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSla404JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewSla404JSONResponse) VisitPostTeamSetReviewSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSla412JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewSla412JSONResponse) VisitPostTeamSetReviewSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewSla500JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewSla500JSONResponse) VisitPostTeamSetReviewSlaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetRequestObject struct {
+	Params GetUsersGetParams
+}
+
+type GetUsersGetResponseObject interface {
+	VisitGetUsersGetResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetUsersGet200JSONResponse struct {
+	Body    User
+	Headers GetUsersGet200ResponseHeaders
+}
+
+func (response GetUsersGet200JSONResponse) VisitGetUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUsersGet404JSONResponse ErrorResponse
+
+func (response GetUsersGet404JSONResponse) VisitGetUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGet500JSONResponse ErrorResponse
+
+func (response GetUsersGet500JSONResponse) VisitGetUsersGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview500JSONResponse ErrorResponse
+
+func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Params PostUsersSetIsActiveParams
+	Body   *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200ResponseHeaders struct {
+	ETag string
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	Body struct {
+		User *User `json:"user,omitempty"`
+	}
+	Headers PostUsersSetIsActive200ResponseHeaders
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive412JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive412JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive422JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive422JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive500JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostVcsIdentitiesLinkRequestObject struct {
+	Body *PostVcsIdentitiesLinkJSONRequestBody
+}
+
+type PostVcsIdentitiesLinkResponseObject interface {
+	VisitPostVcsIdentitiesLinkResponse(w http.ResponseWriter) error
+}
+
+type PostVcsIdentitiesLink200JSONResponse struct {
+	Identity VCSIdentity `json:"identity"`
+}
+
+func (response PostVcsIdentitiesLink200JSONResponse) VisitPostVcsIdentitiesLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostVcsIdentitiesLink400JSONResponse ErrorResponse
+
+func (response PostVcsIdentitiesLink400JSONResponse) VisitPostVcsIdentitiesLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostVcsIdentitiesLink404JSONResponse ErrorResponse
+
+func (response PostVcsIdentitiesLink404JSONResponse) VisitPostVcsIdentitiesLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostVcsIdentitiesLink500JSONResponse ErrorResponse
+
+func (response PostVcsIdentitiesLink500JSONResponse) VisitPostVcsIdentitiesLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVcsIdentitiesListRequestObject struct {
+	Params GetVcsIdentitiesListParams
+}
+
+type GetVcsIdentitiesListResponseObject interface {
+	VisitGetVcsIdentitiesListResponse(w http.ResponseWriter) error
+}
+
+type GetVcsIdentitiesList200JSONResponse struct {
+	Identities []VCSIdentity `json:"identities"`
+}
+
+func (response GetVcsIdentitiesList200JSONResponse) VisitGetVcsIdentitiesListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVcsIdentitiesList500JSONResponse ErrorResponse
+
+func (response GetVcsIdentitiesList500JSONResponse) VisitGetVcsIdentitiesListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeliveriesRequestObject struct {
+	Params GetWebhooksDeliveriesParams
+}
+
+type GetWebhooksDeliveriesResponseObject interface {
+	VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksDeliveries200JSONResponse struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+}
+
+func (response GetWebhooksDeliveries200JSONResponse) VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeliveries400JSONResponse ErrorResponse
+
+func (response GetWebhooksDeliveries400JSONResponse) VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeliveries404JSONResponse ErrorResponse
+
+func (response GetWebhooksDeliveries404JSONResponse) VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksDeliveries500JSONResponse ErrorResponse
+
+func (response GetWebhooksDeliveries500JSONResponse) VisitGetWebhooksDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksListRequestObject struct {
+	Params GetWebhooksListParams
+}
+
+type GetWebhooksListResponseObject interface {
+	VisitGetWebhooksListResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksList200JSONResponse struct {
+	Subscriptions []WebhookSubscription `json:"subscriptions"`
+}
+
+func (response GetWebhooksList200JSONResponse) VisitGetWebhooksListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksList500JSONResponse ErrorResponse
+
+func (response GetWebhooksList500JSONResponse) VisitGetWebhooksListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksSubscribeRequestObject struct {
+	Body *PostWebhooksSubscribeJSONRequestBody
+}
+
+type PostWebhooksSubscribeResponseObject interface {
+	VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksSubscribe201JSONResponse struct {
+	Subscription WebhookSubscription `json:"subscription"`
+}
+
+func (response PostWebhooksSubscribe201JSONResponse) VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksSubscribe400JSONResponse ErrorResponse
+
+func (response PostWebhooksSubscribe400JSONResponse) VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksSubscribe404JSONResponse ErrorResponse
+
+func (response PostWebhooksSubscribe404JSONResponse) VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksSubscribe500JSONResponse ErrorResponse
+
+func (response PostWebhooksSubscribe500JSONResponse) VisitPostWebhooksSubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUnsubscribeRequestObject struct {
+	Body *PostWebhooksUnsubscribeJSONRequestBody
+}
+
+type PostWebhooksUnsubscribeResponseObject interface {
+	VisitPostWebhooksUnsubscribeResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksUnsubscribe200Response struct {
+}
+
+func (response PostWebhooksUnsubscribe200Response) VisitPostWebhooksUnsubscribeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostWebhooksUnsubscribe404JSONResponse ErrorResponse
+
+func (response PostWebhooksUnsubscribe404JSONResponse) VisitPostWebhooksUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUnsubscribe500JSONResponse ErrorResponse
+
+func (response PostWebhooksUnsubscribe500JSONResponse) VisitPostWebhooksUnsubscribeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить журнал изменяющих операций (новые события первыми)
+	// (GET /audit/list)
+	GetAuditList(ctx context.Context, request GetAuditListRequestObject) (GetAuditListResponseObject, error)
+	// Расхождение команд и пользователей с SCIM-каталогом без применения изменений
+	// (GET /directory/drift)
+	GetDirectoryDrift(ctx context.Context, request GetDirectoryDriftRequestObject) (GetDirectoryDriftResponseObject, error)
+	// Немедленно синхронизировать команды и пользователей с SCIM-каталогом
+	// (POST /directory/sync)
+	PostDirectorySync(ctx context.Context, request PostDirectorySyncRequestObject) (PostDirectorySyncResponseObject, error)
+	// Получить настройки уведомлений команды
+	// (GET /notifications/team/settings)
+	GetNotificationsTeamSettings(ctx context.Context, request GetNotificationsTeamSettingsRequestObject) (GetNotificationsTeamSettingsResponseObject, error)
+	// Задать чат и режим уведомлений команды
+	// (POST /notifications/team/settings)
+	PostNotificationsTeamSettings(ctx context.Context, request PostNotificationsTeamSettingsRequestObject) (PostNotificationsTeamSettingsResponseObject, error)
+	// Получить настройки уведомлений пользователя
+	// (GET /notifications/user/preferences)
+	GetNotificationsUserPreferences(ctx context.Context, request GetNotificationsUserPreferencesRequestObject) (GetNotificationsUserPreferencesResponseObject, error)
+	// Задать email и режим уведомлений пользователя
+	// (POST /notifications/user/preferences)
+	PostNotificationsUserPreferences(ctx context.Context, request PostNotificationsUserPreferencesRequestObject) (PostNotificationsUserPreferencesResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Получить PR с назначениями ревьюверов
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx context.Context, request GetPullRequestGetRequestObject) (GetPullRequestGetResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Зафиксировать решение ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx context.Context, request PostPullRequestReviewRequestObject) (PostPullRequestReviewResponseObject, error)
+	// Перцентили времени цикла слитых PR по неделям
+	// (GET /stats/cycleTime)
+	GetStatsCycleTime(ctx context.Context, request GetStatsCycleTimeRequestObject) (GetStatsCycleTimeResponseObject, error)
+	// Равномерность распределения ревью в команде за окно [from, to)
+	// (GET /stats/fairness)
+	GetStatsFairness(ctx context.Context, request GetStatsFairnessRequestObject) (GetStatsFairnessResponseObject, error)
+	// Статистика ревью по пользователям за окно [from, to)
+	// (GET /stats/reviewers)
+	GetStatsReviewers(ctx context.Context, request GetStatsReviewersRequestObject) (GetStatsReviewersResponseObject, error)
+	// Статистика ревью по командам за окно [from, to)
+	// (GET /stats/teams)
+	GetStatsTeams(ctx context.Context, request GetStatsTeamsRequestObject) (GetStatsTeamsResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Массовый импорт команд и участников из CSV или YAML одной транзакцией
+	// (POST /team/import)
+	PostTeamImport(ctx context.Context, request PostTeamImportRequestObject) (PostTeamImportResponseObject, error)
+	// Задать SLA ревью команды, после которого ревьювер переназначается
+	// (POST /team/setReviewSla)
+	PostTeamSetReviewSla(ctx context.Context, request PostTeamSetReviewSlaRequestObject) (PostTeamSetReviewSlaResponseObject, error)
+	// Получить пользователя
+	// (GET /users/get)
+	GetUsersGet(ctx context.Context, request GetUsersGetRequestObject) (GetUsersGetResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Сопоставить логин GitHub/GitLab пользователю сервиса (перезаписывает существующую связь)
+	// (POST /vcs/identities/link)
+	PostVcsIdentitiesLink(ctx context.Context, request PostVcsIdentitiesLinkRequestObject) (PostVcsIdentitiesLinkResponseObject, error)
+	// Получить логины GitHub/GitLab пользователя
+	// (GET /vcs/identities/list)
+	GetVcsIdentitiesList(ctx context.Context, request GetVcsIdentitiesListRequestObject) (GetVcsIdentitiesListResponseObject, error)
+	// Журнал доставок подписки (новые первыми)
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(ctx context.Context, request GetWebhooksDeliveriesRequestObject) (GetWebhooksDeliveriesResponseObject, error)
+	// Получить подписки команды
+	// (GET /webhooks/list)
+	GetWebhooksList(ctx context.Context, request GetWebhooksListRequestObject) (GetWebhooksListResponseObject, error)
+	// Подписать команду на исходящие вебхуки (тело подписывается заголовком X-Signature, HMAC-SHA256)
+	// (POST /webhooks/subscribe)
+	PostWebhooksSubscribe(ctx context.Context, request PostWebhooksSubscribeRequestObject) (PostWebhooksSubscribeResponseObject, error)
+	// Удалить подписку вместе с журналом её доставок
+	// (POST /webhooks/unsubscribe)
+	PostWebhooksUnsubscribe(ctx context.Context, request PostWebhooksUnsubscribeRequestObject) (PostWebhooksUnsubscribeResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAuditList operation middleware
+func (sh *strictHandler) GetAuditList(w http.ResponseWriter, r *http.Request, params GetAuditListParams) {
+	var request GetAuditListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditList(ctx, request.(GetAuditListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditListResponseObject); ok {
+		if err := validResponse.VisitGetAuditListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDirectoryDrift operation middleware
+func (sh *strictHandler) GetDirectoryDrift(w http.ResponseWriter, r *http.Request) {
+	var request GetDirectoryDriftRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDirectoryDrift(ctx, request.(GetDirectoryDriftRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDirectoryDrift")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDirectoryDriftResponseObject); ok {
+		if err := validResponse.VisitGetDirectoryDriftResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDirectorySync operation middleware
+func (sh *strictHandler) PostDirectorySync(w http.ResponseWriter, r *http.Request, params PostDirectorySyncParams) {
+	var request PostDirectorySyncRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDirectorySync(ctx, request.(PostDirectorySyncRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDirectorySync")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostDirectorySyncResponseObject); ok {
+		if err := validResponse.VisitPostDirectorySyncResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNotificationsTeamSettings operation middleware
+func (sh *strictHandler) GetNotificationsTeamSettings(w http.ResponseWriter, r *http.Request, params GetNotificationsTeamSettingsParams) {
+	var request GetNotificationsTeamSettingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationsTeamSettings(ctx, request.(GetNotificationsTeamSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationsTeamSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNotificationsTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitGetNotificationsTeamSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostNotificationsTeamSettings operation middleware
+func (sh *strictHandler) PostNotificationsTeamSettings(w http.ResponseWriter, r *http.Request) {
+	var request PostNotificationsTeamSettingsRequestObject
+
+	var body PostNotificationsTeamSettingsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNotificationsTeamSettings(ctx, request.(PostNotificationsTeamSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNotificationsTeamSettings")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostNotificationsTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitPostNotificationsTeamSettingsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNotificationsUserPreferences operation middleware
+func (sh *strictHandler) GetNotificationsUserPreferences(w http.ResponseWriter, r *http.Request, params GetNotificationsUserPreferencesParams) {
+	var request GetNotificationsUserPreferencesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNotificationsUserPreferences(ctx, request.(GetNotificationsUserPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNotificationsUserPreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNotificationsUserPreferencesResponseObject); ok {
+		if err := validResponse.VisitGetNotificationsUserPreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostNotificationsUserPreferences operation middleware
+func (sh *strictHandler) PostNotificationsUserPreferences(w http.ResponseWriter, r *http.Request) {
+	var request PostNotificationsUserPreferencesRequestObject
+
+	var body PostNotificationsUserPreferencesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostNotificationsUserPreferences(ctx, request.(PostNotificationsUserPreferencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostNotificationsUserPreferences")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostNotificationsUserPreferencesResponseObject); ok {
+		if err := validResponse.VisitPostNotificationsUserPreferencesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request, params PostPullRequestCreateParams) {
+	var request PostPullRequestCreateRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestGet operation middleware
+func (sh *strictHandler) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	var request GetPullRequestGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestGet(ctx, request.(GetPullRequestGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestGetResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	var request PostPullRequestMergeRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	var request PostPullRequestReassignRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReview operation middleware
+func (sh *strictHandler) PostPullRequestReview(w http.ResponseWriter, r *http.Request, params PostPullRequestReviewParams) {
+	var request PostPullRequestReviewRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReviewJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReview(ctx, request.(PostPullRequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsCycleTime operation middleware
+func (sh *strictHandler) GetStatsCycleTime(w http.ResponseWriter, r *http.Request, params GetStatsCycleTimeParams) {
+	var request GetStatsCycleTimeRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsCycleTime(ctx, request.(GetStatsCycleTimeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsCycleTime")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsCycleTimeResponseObject); ok {
+		if err := validResponse.VisitGetStatsCycleTimeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsFairness operation middleware
+func (sh *strictHandler) GetStatsFairness(w http.ResponseWriter, r *http.Request, params GetStatsFairnessParams) {
+	var request GetStatsFairnessRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsFairness(ctx, request.(GetStatsFairnessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsFairness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsFairnessResponseObject); ok {
+		if err := validResponse.VisitGetStatsFairnessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsReviewers operation middleware
+func (sh *strictHandler) GetStatsReviewers(w http.ResponseWriter, r *http.Request, params GetStatsReviewersParams) {
+	var request GetStatsReviewersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsReviewers(ctx, request.(GetStatsReviewersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsReviewers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsReviewersResponseObject); ok {
+		if err := validResponse.VisitGetStatsReviewersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsTeams operation middleware
+func (sh *strictHandler) GetStatsTeams(w http.ResponseWriter, r *http.Request, params GetStatsTeamsParams) {
+	var request GetStatsTeamsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsTeams(ctx, request.(GetStatsTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsTeams")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatsTeamsResponseObject); ok {
+		if err := validResponse.VisitGetStatsTeamsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
+	var request PostTeamAddRequestObject
+
+	request.Params = params
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetTeamGet500JSONResponse ErrorResponse
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
 
-func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
-}
+// PostTeamImport operation middleware
+func (sh *strictHandler) PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams) {
+	var request PostTeamImportRequestObject
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Body = r.Body
 
-type GetUsersGetReview500JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamImport(ctx, request.(PostTeamImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamImport")
+	}
 
-func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamImportResponseObject); ok {
+		if err := validResponse.VisitPostTeamImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+// PostTeamSetReviewSla operation middleware
+func (sh *strictHandler) PostTeamSetReviewSla(w http.ResponseWriter, r *http.Request, params PostTeamSetReviewSlaParams) {
+	var request PostTeamSetReviewSlaRequestObject
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
-}
+	var body PostTeamSetReviewSlaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetReviewSla(ctx, request.(PostTeamSetReviewSlaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetReviewSla")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamSetReviewSlaResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetReviewSlaResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetUsersGet operation middleware
+func (sh *strictHandler) GetUsersGet(w http.ResponseWriter, r *http.Request, params GetUsersGetParams) {
+	var request GetUsersGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActive500JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGet(ctx, request.(GetUsersGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGet")
+	}
 
-func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersGetResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
-}
+// GetUsersGetReview operation middleware
+func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	var request GetUsersGetReviewRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.Params = params
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetReview")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// PostUsersSetIsActive operation middleware
+func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request, params PostUsersSetIsActiveParams) {
+	var request PostUsersSetIsActiveRequestObject
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestCreateRequestObject
+	request.Params = params
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostUsersSetIsActiveJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -845,18 +4398,18 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostUsersSetIsActive")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetIsActiveResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -864,11 +4417,11 @@ func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestMergeRequestObject
+// PostVcsIdentitiesLink operation middleware
+func (sh *strictHandler) PostVcsIdentitiesLink(w http.ResponseWriter, r *http.Request) {
+	var request PostVcsIdentitiesLinkRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	var body PostVcsIdentitiesLinkJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -876,18 +4429,18 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostVcsIdentitiesLink(ctx, request.(PostVcsIdentitiesLinkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostVcsIdentitiesLink")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostVcsIdentitiesLinkResponseObject); ok {
+		if err := validResponse.VisitPostVcsIdentitiesLinkResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -895,30 +4448,25 @@ func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	var request PostPullRequestReassignRequestObject
+// GetVcsIdentitiesList operation middleware
+func (sh *strictHandler) GetVcsIdentitiesList(w http.ResponseWriter, r *http.Request, params GetVcsIdentitiesListParams) {
+	var request GetVcsIdentitiesListRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.GetVcsIdentitiesList(ctx, request.(GetVcsIdentitiesListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "GetVcsIdentitiesList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetVcsIdentitiesListResponseObject); ok {
+		if err := validResponse.VisitGetVcsIdentitiesListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -926,30 +4474,25 @@ func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	var request PostTeamAddRequestObject
+// GetWebhooksDeliveries operation middleware
+func (sh *strictHandler) GetWebhooksDeliveries(w http.ResponseWriter, r *http.Request, params GetWebhooksDeliveriesParams) {
+	var request GetWebhooksDeliveriesRequestObject
 
-	var body PostTeamAddJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.GetWebhooksDeliveries(ctx, request.(GetWebhooksDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "GetWebhooksDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksDeliveriesResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksDeliveriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -957,25 +4500,25 @@ func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// GetWebhooksList operation middleware
+func (sh *strictHandler) GetWebhooksList(w http.ResponseWriter, r *http.Request, params GetWebhooksListParams) {
+	var request GetWebhooksListRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.GetWebhooksList(ctx, request.(GetWebhooksListRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "GetWebhooksList")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetWebhooksListResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksListResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -983,25 +4526,30 @@ func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, para
 	}
 }
 
-// GetUsersGetReview operation middleware
-func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	var request GetUsersGetReviewRequestObject
+// PostWebhooksSubscribe operation middleware
+func (sh *strictHandler) PostWebhooksSubscribe(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksSubscribeRequestObject
 
-	request.Params = params
+	var body PostWebhooksSubscribeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+		return sh.ssi.PostWebhooksSubscribe(ctx, request.(PostWebhooksSubscribeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersGetReview")
+		handler = middleware(handler, "PostWebhooksSubscribe")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
-		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksSubscribeResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksSubscribeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1009,11 +4557,11 @@ func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// PostUsersSetIsActive operation middleware
-func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	var request PostUsersSetIsActiveRequestObject
+// PostWebhooksUnsubscribe operation middleware
+func (sh *strictHandler) PostWebhooksUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksUnsubscribeRequestObject
 
-	var body PostUsersSetIsActiveJSONRequestBody
+	var body PostWebhooksUnsubscribeJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1021,18 +4569,18 @@ func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
+		return sh.ssi.PostWebhooksUnsubscribe(ctx, request.(PostWebhooksUnsubscribeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersSetIsActive")
+		handler = middleware(handler, "PostWebhooksUnsubscribe")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
-		if err := validResponse.VisitPostUsersSetIsActiveResponse(w); err != nil {
+	} else if validResponse, ok := response.(PostWebhooksUnsubscribeResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksUnsubscribeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
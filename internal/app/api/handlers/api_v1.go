@@ -2,26 +2,58 @@ package handlers
 
 import (
 	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/api/handlers/audit"
+	"avito-backend-intern-assignment/internal/app/api/handlers/directory"
+	"avito-backend-intern-assignment/internal/app/api/handlers/notification"
 	"avito-backend-intern-assignment/internal/app/api/handlers/pullrequest"
+	"avito-backend-intern-assignment/internal/app/api/handlers/stats"
 	"avito-backend-intern-assignment/internal/app/api/handlers/team"
 	"avito-backend-intern-assignment/internal/app/api/handlers/user"
+	"avito-backend-intern-assignment/internal/app/api/handlers/vcs"
+	"avito-backend-intern-assignment/internal/app/api/handlers/webhook"
 	"context"
 )
 
 type ApiV1 struct {
-	teamHandler *team.Handler
-	userHandler *user.Handler
-	prHandler   *pullrequest.Handler
+	teamHandler    *team.Handler
+	userHandler    *user.Handler
+	prHandler      *pullrequest.Handler
+	auditHandler   *audit.Handler
+	webhookHandler *webhook.Handler
+	vcsHandler     *vcs.Handler
+	notifyHandler  *notification.Handler
+	statsHandler   *stats.Handler
+	dirHandler     *directory.Handler
 }
 
-func NewApiV1(th *team.Handler, uh *user.Handler, prh *pullrequest.Handler) *ApiV1 {
+func NewApiV1(
+	th *team.Handler,
+	uh *user.Handler,
+	prh *pullrequest.Handler,
+	ah *audit.Handler,
+	wh *webhook.Handler,
+	vh *vcs.Handler,
+	nh *notification.Handler,
+	sth *stats.Handler,
+	dh *directory.Handler,
+) *ApiV1 {
 	return &ApiV1{
-		teamHandler: th,
-		userHandler: uh,
-		prHandler:   prh,
+		teamHandler:    th,
+		userHandler:    uh,
+		prHandler:      prh,
+		auditHandler:   ah,
+		webhookHandler: wh,
+		vcsHandler:     vh,
+		notifyHandler:  nh,
+		statsHandler:   sth,
+		dirHandler:     dh,
 	}
 }
 
+func (av *ApiV1) GetAuditList(ctx context.Context, request api.GetAuditListRequestObject) (api.GetAuditListResponseObject, error) {
+	return av.auditHandler.GetAuditList(ctx, request)
+}
+
 func (av *ApiV1) GetTeamGet(ctx context.Context, request api.GetTeamGetRequestObject) (api.GetTeamGetResponseObject, error) {
 	return av.teamHandler.GetTeamGet(ctx, request)
 }
@@ -30,6 +62,10 @@ func (av *ApiV1) GetUsersGetReview(ctx context.Context, request api.GetUsersGetR
 	return av.prHandler.GetUsersGetReview(ctx, request)
 }
 
+func (av *ApiV1) GetPullRequestGet(ctx context.Context, request api.GetPullRequestGetRequestObject) (api.GetPullRequestGetResponseObject, error) {
+	return av.prHandler.GetPullRequestGet(ctx, request)
+}
+
 func (av *ApiV1) PostPullRequestCreate(ctx context.Context, request api.PostPullRequestCreateRequestObject) (api.PostPullRequestCreateResponseObject, error) {
 	return av.prHandler.PostPullRequestCreate(ctx, request)
 }
@@ -42,12 +78,92 @@ func (av *ApiV1) PostPullRequestReassign(ctx context.Context, request api.PostPu
 	return av.prHandler.PostPullRequestReassign(ctx, request)
 }
 
+func (av *ApiV1) PostPullRequestReview(ctx context.Context, request api.PostPullRequestReviewRequestObject) (api.PostPullRequestReviewResponseObject, error) {
+	return av.prHandler.PostPullRequestReview(ctx, request)
+}
+
+func (av *ApiV1) PostTeamSetReviewSla(ctx context.Context, request api.PostTeamSetReviewSlaRequestObject) (api.PostTeamSetReviewSlaResponseObject, error) {
+	return av.teamHandler.PostTeamSetReviewSla(ctx, request)
+}
+
 func (av *ApiV1) PostTeamAdd(ctx context.Context, request api.PostTeamAddRequestObject) (api.PostTeamAddResponseObject, error) {
 	return av.teamHandler.PostTeamAdd(ctx, request)
 }
 
+func (av *ApiV1) PostTeamImport(ctx context.Context, request api.PostTeamImportRequestObject) (api.PostTeamImportResponseObject, error) {
+	return av.teamHandler.PostTeamImport(ctx, request)
+}
+
+func (av *ApiV1) GetUsersGet(ctx context.Context, request api.GetUsersGetRequestObject) (api.GetUsersGetResponseObject, error) {
+	return av.userHandler.GetUsersGet(ctx, request)
+}
+
 func (av *ApiV1) PostUsersSetIsActive(ctx context.Context, request api.PostUsersSetIsActiveRequestObject) (api.PostUsersSetIsActiveResponseObject, error) {
 	return av.userHandler.PostUsersSetIsActive(ctx, request)
 }
 
+func (av *ApiV1) PostWebhooksSubscribe(ctx context.Context, request api.PostWebhooksSubscribeRequestObject) (api.PostWebhooksSubscribeResponseObject, error) {
+	return av.webhookHandler.PostWebhooksSubscribe(ctx, request)
+}
+
+func (av *ApiV1) GetWebhooksList(ctx context.Context, request api.GetWebhooksListRequestObject) (api.GetWebhooksListResponseObject, error) {
+	return av.webhookHandler.GetWebhooksList(ctx, request)
+}
+
+func (av *ApiV1) PostWebhooksUnsubscribe(ctx context.Context, request api.PostWebhooksUnsubscribeRequestObject) (api.PostWebhooksUnsubscribeResponseObject, error) {
+	return av.webhookHandler.PostWebhooksUnsubscribe(ctx, request)
+}
+
+func (av *ApiV1) GetWebhooksDeliveries(ctx context.Context, request api.GetWebhooksDeliveriesRequestObject) (api.GetWebhooksDeliveriesResponseObject, error) {
+	return av.webhookHandler.GetWebhooksDeliveries(ctx, request)
+}
+
+func (av *ApiV1) PostVcsIdentitiesLink(ctx context.Context, request api.PostVcsIdentitiesLinkRequestObject) (api.PostVcsIdentitiesLinkResponseObject, error) {
+	return av.vcsHandler.PostVcsIdentitiesLink(ctx, request)
+}
+
+func (av *ApiV1) GetVcsIdentitiesList(ctx context.Context, request api.GetVcsIdentitiesListRequestObject) (api.GetVcsIdentitiesListResponseObject, error) {
+	return av.vcsHandler.GetVcsIdentitiesList(ctx, request)
+}
+
+func (av *ApiV1) GetNotificationsTeamSettings(ctx context.Context, request api.GetNotificationsTeamSettingsRequestObject) (api.GetNotificationsTeamSettingsResponseObject, error) {
+	return av.notifyHandler.GetNotificationsTeamSettings(ctx, request)
+}
+
+func (av *ApiV1) PostNotificationsTeamSettings(ctx context.Context, request api.PostNotificationsTeamSettingsRequestObject) (api.PostNotificationsTeamSettingsResponseObject, error) {
+	return av.notifyHandler.PostNotificationsTeamSettings(ctx, request)
+}
+
+func (av *ApiV1) GetNotificationsUserPreferences(ctx context.Context, request api.GetNotificationsUserPreferencesRequestObject) (api.GetNotificationsUserPreferencesResponseObject, error) {
+	return av.notifyHandler.GetNotificationsUserPreferences(ctx, request)
+}
+
+func (av *ApiV1) PostNotificationsUserPreferences(ctx context.Context, request api.PostNotificationsUserPreferencesRequestObject) (api.PostNotificationsUserPreferencesResponseObject, error) {
+	return av.notifyHandler.PostNotificationsUserPreferences(ctx, request)
+}
+
+func (av *ApiV1) GetStatsReviewers(ctx context.Context, request api.GetStatsReviewersRequestObject) (api.GetStatsReviewersResponseObject, error) {
+	return av.statsHandler.GetStatsReviewers(ctx, request)
+}
+
+func (av *ApiV1) GetStatsTeams(ctx context.Context, request api.GetStatsTeamsRequestObject) (api.GetStatsTeamsResponseObject, error) {
+	return av.statsHandler.GetStatsTeams(ctx, request)
+}
+
+func (av *ApiV1) GetStatsFairness(ctx context.Context, request api.GetStatsFairnessRequestObject) (api.GetStatsFairnessResponseObject, error) {
+	return av.statsHandler.GetStatsFairness(ctx, request)
+}
+
+func (av *ApiV1) GetStatsCycleTime(ctx context.Context, request api.GetStatsCycleTimeRequestObject) (api.GetStatsCycleTimeResponseObject, error) {
+	return av.statsHandler.GetStatsCycleTime(ctx, request)
+}
+
+func (av *ApiV1) GetDirectoryDrift(ctx context.Context, request api.GetDirectoryDriftRequestObject) (api.GetDirectoryDriftResponseObject, error) {
+	return av.dirHandler.GetDirectoryDrift(ctx, request)
+}
+
+func (av *ApiV1) PostDirectorySync(ctx context.Context, request api.PostDirectorySyncRequestObject) (api.PostDirectorySyncResponseObject, error) {
+	return av.dirHandler.PostDirectorySync(ctx, request)
+}
+
 var _ api.StrictServerInterface = (*ApiV1)(nil)
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/mappers"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"context"
+	"log"
+	"time"
+)
+
+type Handler struct {
+	auditService service.Audit
+}
+
+func NewHandler(auditService service.Audit) *Handler {
+	return &Handler{
+		auditService: auditService,
+	}
+}
+
+func (h *Handler) GetAuditList(ctx context.Context, request api.GetAuditListRequestObject) (api.GetAuditListResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	params := request.Params
+	if params.Limit != nil && (*params.Limit < 1 || *params.Limit > audit.MaxListLimit) {
+		return api.GetAuditList400JSONResponse{}, nil
+	}
+	if params.Offset != nil && *params.Offset < 0 {
+		return api.GetAuditList400JSONResponse{}, nil
+	}
+
+	events, err := h.auditService.List(serviceCtx, mappers.ToEntityAuditFilter(params))
+	if err != nil {
+		log.Printf("Handler: Failed to list audit events: %v", err)
+		return api.GetAuditList500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetAuditList200JSONResponse{
+		Events: mappers.ToApiAuditEvents(events),
+	}, nil
+}
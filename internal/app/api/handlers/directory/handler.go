@@ -0,0 +1,60 @@
+package directory
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/mappers"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/directory"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+// syncTimeout покрывает постраничное чтение каталога и переназначение ревью.
+const syncTimeout = 30 * time.Second
+
+type Handler struct {
+	directoryService service.Directory
+}
+
+func NewHandler(directoryService service.Directory) *Handler {
+	return &Handler{
+		directoryService: directoryService,
+	}
+}
+
+func (h *Handler) GetDirectoryDrift(ctx context.Context, _ api.GetDirectoryDriftRequestObject) (api.GetDirectoryDriftResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+
+	report, err := h.directoryService.Sync(serviceCtx, true, false)
+	if err != nil {
+		if errors.Is(err, directory.ErrSyncDisabled) {
+			return api.GetDirectoryDrift404JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to compute directory drift: %v", err)
+		return api.GetDirectoryDrift500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetDirectoryDrift200JSONResponse(mappers.ToApiDirectorySyncReport(report)), nil
+}
+
+func (h *Handler) PostDirectorySync(ctx context.Context, request api.PostDirectorySyncRequestObject) (api.PostDirectorySyncResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, syncTimeout)
+	defer cancel()
+
+	force := request.Params.Force != nil && *request.Params.Force
+	report, err := h.directoryService.Sync(serviceCtx, false, force)
+	if err != nil {
+		if errors.Is(err, directory.ErrSyncDisabled) {
+			return api.PostDirectorySync404JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to sync directory: %v", err)
+		return api.PostDirectorySync500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.PostDirectorySync200JSONResponse(mappers.ToApiDirectorySyncReport(report)), nil
+}
@@ -0,0 +1,83 @@
+package events
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// StreamHandler отдаёт события шины через Server-Sent Events. Маршрут не описан в
+// openapi.yml, так как strict-сервер не умеет держать потоковый ответ.
+type StreamHandler struct {
+	bus       *eventbus.Bus
+	heartbeat time.Duration
+}
+
+func NewStreamHandler(bus *eventbus.Bus, heartbeat time.Duration) *StreamHandler {
+	return &StreamHandler{
+		bus:       bus,
+		heartbeat: heartbeat,
+	}
+}
+
+type message struct {
+	Type    string `json:"type"`
+	Payload any    `json:"payload"`
+}
+
+// Stream обслуживает GET /events/stream?user_id=&team_name=.
+func (h *StreamHandler) Stream(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	filter := eventbus.Filter{
+		UserID:   r.URL.Query().Get("user_id"),
+		TeamName: r.URL.Query().Get("team_name"),
+	}
+
+	sub := h.bus.Subscribe(filter)
+	defer sub.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, ": connected\n\n")
+	flusher.Flush()
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case e, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(message{Type: string(e.EventType()), Payload: e})
+			if err != nil {
+				log.Printf("ERROR: Failed to marshal %s for stream: %v", e.EventType(), err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.EventType(), data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
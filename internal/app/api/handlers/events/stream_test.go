@@ -0,0 +1,107 @@
+package events_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api/handlers/events"
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStream_PushesFilteredEvents(t *testing.T) {
+	bus := eventbus.New(4)
+	srv := httptest.NewServer(http.HandlerFunc(events.NewStreamHandler(bus, time.Hour).Stream))
+	defer srv.Close()
+	defer bus.Close()
+
+	resp, err := http.Get(srv.URL + "?user_id=u2")
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+	}()
+
+	next := func() string {
+		t.Helper()
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				t.Fatal("stream closed unexpectedly")
+			}
+			return line
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for stream")
+			return ""
+		}
+	}
+
+	// комментарий о подключении приходит после подписки, дальше публиковать безопасно
+	if line := next(); line != ": connected" {
+		t.Fatalf("unexpected first line %q", line)
+	}
+	next()
+
+	bus.Publish(
+		event.ReviewerAssigned{PullRequestID: "pr-1", ReviewerID: "u3", TeamName: "backend"},
+		event.ReviewerAssigned{PullRequestID: "pr-2", ReviewerID: "u2", TeamName: "backend"},
+	)
+
+	if line := next(); line != "event: "+string(event.TypeReviewerAssigned) {
+		t.Fatalf("unexpected event line %q", line)
+	}
+	data := next()
+	if !strings.HasPrefix(data, "data: ") || !strings.Contains(data, `"pr-2"`) {
+		t.Fatalf("unexpected data line %q", data)
+	}
+}
+
+func TestStream_EndsOnBusClose(t *testing.T) {
+	bus := eventbus.New(1)
+	srv := httptest.NewServer(http.HandlerFunc(events.NewStreamHandler(bus, time.Hour).Stream))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	defer resp.Body.Close()
+
+	reader := bufio.NewReader(resp.Body)
+	if _, err := reader.ReadString('\n'); err != nil {
+		t.Fatalf("read greeting: %v", err)
+	}
+
+	bus.Close()
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			if _, err := reader.ReadString('\n'); err != nil {
+				close(done)
+				return
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("stream was not closed after bus shutdown")
+	}
+}
@@ -0,0 +1,67 @@
+package export
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+var contentTypes = map[entity.ExportFormat]string{
+	entity.ExportCSV:    "text/csv",
+	entity.ExportNDJSON: "application/x-ndjson",
+}
+
+// Handler отдаёт полные выгрузки таблиц потоком. Как и /events/stream, маршрут
+// не описан в openapi.yml: strict-сервер буферизует ответ целиком.
+type Handler struct {
+	exportService service.Export
+}
+
+func NewHandler(exportService service.Export) *Handler {
+	return &Handler{
+		exportService: exportService,
+	}
+}
+
+// Export обслуживает GET /export/{dataset}?format=csv|ndjson.
+func (h *Handler) Export(w http.ResponseWriter, r *http.Request) {
+	dataset := entity.ExportDataset(chi.URLParam(r, "dataset"))
+	if !dataset.Valid() {
+		writeError(w, http.StatusNotFound, api.NOTFOUND, fmt.Sprintf("unknown dataset %q", dataset))
+		return
+	}
+
+	format := entity.ExportCSV
+	if f := r.URL.Query().Get("format"); f != "" {
+		format = entity.ExportFormat(f)
+	}
+	if !format.Valid() {
+		writeError(w, http.StatusBadRequest, api.INVALIDREQUEST, fmt.Sprintf("unknown format %q", format))
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypes[format])
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, dataset, format))
+
+	// после первой строки статус уже отправлен, поэтому ошибка только логируется,
+	// а клиент увидит оборванный ответ
+	if err := h.exportService.Export(r.Context(), dataset, format, w); err != nil {
+		log.Printf("ERROR: Export of %s failed: %v", dataset, err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, code api.ErrorResponseErrorCode, message string) {
+	var resp api.ErrorResponse
+	resp.Error.Code = code
+	resp.Error.Message = message
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
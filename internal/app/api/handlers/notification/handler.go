@@ -0,0 +1,106 @@
+package notification
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/mappers"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/notification"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+type Handler struct {
+	notificationService service.Notification
+}
+
+func NewHandler(notificationService service.Notification) *Handler {
+	return &Handler{
+		notificationService: notificationService,
+	}
+}
+
+func (h *Handler) GetNotificationsTeamSettings(ctx context.Context, request api.GetNotificationsTeamSettingsRequestObject) (api.GetNotificationsTeamSettingsResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	settings, err := h.notificationService.GetTeamSettings(serviceCtx, request.Params.TeamName)
+	if err != nil {
+		if errors.Is(err, team.ErrTeamNotFound) {
+			return api.GetNotificationsTeamSettings404JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to get team notification settings: %v", err)
+		return api.GetNotificationsTeamSettings500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetNotificationsTeamSettings200JSONResponse{
+		Settings: mappers.ToApiTeamNotificationSettings(settings),
+	}, nil
+}
+
+func (h *Handler) PostNotificationsTeamSettings(ctx context.Context, request api.PostNotificationsTeamSettingsRequestObject) (api.PostNotificationsTeamSettingsResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	settings, err := h.notificationService.SetTeamSettings(serviceCtx, mappers.ToEntityTeamNotificationSettings(*request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, notification.ErrInvalidURL), errors.Is(err, notification.ErrInvalidMode):
+			return api.PostNotificationsTeamSettings400JSONResponse{}, nil
+		case errors.Is(err, team.ErrTeamNotFound):
+			return api.PostNotificationsTeamSettings404JSONResponse{}, nil
+		default:
+			log.Printf("Handler: Failed to set team notification settings: %v", err)
+			return api.PostNotificationsTeamSettings500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	return api.PostNotificationsTeamSettings200JSONResponse{
+		Settings: mappers.ToApiTeamNotificationSettings(settings),
+	}, nil
+}
+
+func (h *Handler) GetNotificationsUserPreferences(ctx context.Context, request api.GetNotificationsUserPreferencesRequestObject) (api.GetNotificationsUserPreferencesResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	prefs, err := h.notificationService.GetUserPreferences(serviceCtx, request.Params.UserId)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return api.GetNotificationsUserPreferences404JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to get user notification preferences: %v", err)
+		return api.GetNotificationsUserPreferences500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetNotificationsUserPreferences200JSONResponse{
+		Preferences: mappers.ToApiUserNotificationPreferences(prefs),
+	}, nil
+}
+
+func (h *Handler) PostNotificationsUserPreferences(ctx context.Context, request api.PostNotificationsUserPreferencesRequestObject) (api.PostNotificationsUserPreferencesResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	prefs, err := h.notificationService.SetUserPreferences(serviceCtx, mappers.ToEntityUserNotificationPreferences(*request.Body))
+	if err != nil {
+		switch {
+		case errors.Is(err, notification.ErrInvalidEmail), errors.Is(err, notification.ErrInvalidMode):
+			return api.PostNotificationsUserPreferences400JSONResponse{}, nil
+		case errors.Is(err, user.ErrUserNotFound):
+			return api.PostNotificationsUserPreferences404JSONResponse{}, nil
+		default:
+			log.Printf("Handler: Failed to set user notification preferences: %v", err)
+			return api.PostNotificationsUserPreferences500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	return api.PostNotificationsUserPreferences200JSONResponse{
+		Preferences: mappers.ToApiUserNotificationPreferences(prefs),
+	}, nil
+}
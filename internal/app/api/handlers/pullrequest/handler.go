@@ -6,6 +6,7 @@ import (
 	"avito-backend-intern-assignment/internal/app/application/service"
 	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
 	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
 	"context"
 	"errors"
 	"log"
@@ -66,41 +67,99 @@ func (h *Handler) PostPullRequestMerge(ctx context.Context, request api.PostPull
 	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
 	defer cancel()
 
+	serviceCtx = api.WithIfMatch(serviceCtx, request.Params.IfMatch, entity.AuditEntityPullRequest, request.Body.PullRequestId)
 	prEntity, err := h.prService.MarkMerged(serviceCtx, request.Body.PullRequestId)
 	if err != nil {
-		if err == pullrequest.ErrPullRequestNotFound {
+		switch {
+		case errors.Is(err, pullrequest.ErrPullRequestNotFound):
 			return api.PostPullRequestMerge404JSONResponse{}, nil
+		case errors.Is(err, service.ErrVersionMismatch):
+			return api.PostPullRequestMerge412JSONResponse(api.VersionMismatch()), nil
 		}
 
 		return api.PostPullRequestMerge500JSONResponse{}, api.ErrInternalServer
 	}
 
 	prDTO := mappers.ToApiPullRequest(*prEntity)
-	return api.PostPullRequestMerge200JSONResponse{
-		Pr: &prDTO,
-	}, nil
+	var resp api.PostPullRequestMerge200JSONResponse
+	resp.Body.Pr = &prDTO
+	resp.Headers.ETag = api.ETag(prEntity.Version)
+	return resp, nil
 }
 
 func (h *Handler) PostPullRequestReassign(ctx context.Context, request api.PostPullRequestReassignRequestObject) (api.PostPullRequestReassignResponseObject, error) {
 	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
 	defer cancel()
 
+	serviceCtx = api.WithIfMatch(serviceCtx, request.Params.IfMatch, entity.AuditEntityPullRequest, request.Body.PullRequestId)
 	prEntity, newAssignedUserID, err := h.prService.ReassignReviewer(serviceCtx, request.Body.PullRequestId, request.Body.OldUserId)
 	if err != nil {
-		switch err {
-		case pullrequest.ErrPullRequestNotFound, user.ErrUserNotFound:
+		switch {
+		case errors.Is(err, service.ErrVersionMismatch):
+			return api.PostPullRequestReassign412JSONResponse(api.VersionMismatch()), nil
+		case errors.Is(err, pullrequest.ErrPullRequestNotFound), errors.Is(err, user.ErrUserNotFound):
 			return api.PostPullRequestReassign404JSONResponse{}, nil
-		case pullrequest.ErrReassignViolation:
+		case errors.Is(err, pullrequest.ErrReassignViolation):
 			return api.PostPullRequestReassign409JSONResponse{}, nil
 		default:
 			return api.PostPullRequestReassign500JSONResponse{}, api.ErrInternalServer
 		}
 	}
 
-	prDTO := mappers.ToApiPullRequest(*prEntity)
-	return api.PostPullRequestReassign200JSONResponse{
-		ReplacedBy: newAssignedUserID,
-		Pr:         prDTO,
+	var resp api.PostPullRequestReassign200JSONResponse
+	resp.Body.ReplacedBy = newAssignedUserID
+	resp.Body.Pr = mappers.ToApiPullRequest(*prEntity)
+	resp.Headers.ETag = api.ETag(prEntity.Version)
+	return resp, nil
+}
+
+func (h *Handler) PostPullRequestReview(ctx context.Context, request api.PostPullRequestReviewRequestObject) (api.PostPullRequestReviewResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	serviceCtx = api.WithIfMatch(serviceCtx, request.Params.IfMatch, entity.AuditEntityPullRequest, request.Body.PullRequestId)
+	prEntity, err := h.prService.SubmitReview(serviceCtx, request.Body.PullRequestId, request.Body.ReviewerId,
+		entity.ReviewDecision(request.Body.Decision))
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrVersionMismatch):
+			return api.PostPullRequestReview412JSONResponse(api.VersionMismatch()), nil
+		case errors.Is(err, pullrequest.ErrInvalidDecision):
+			return api.PostPullRequestReview400JSONResponse{}, nil
+		case errors.Is(err, pullrequest.ErrPullRequestNotFound):
+			return api.PostPullRequestReview404JSONResponse{}, nil
+		case errors.Is(err, pullrequest.ErrPullRequestMerged),
+			errors.Is(err, pullrequest.ErrPullRequestNotOpen),
+			errors.Is(err, pullrequest.ErrReviewerNotAssigned):
+			return api.PostPullRequestReview409JSONResponse{}, nil
+		default:
+			log.Printf("Handler: Internal server error during review submission: %v", err)
+			return api.PostPullRequestReview500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	var resp api.PostPullRequestReview200JSONResponse
+	resp.Body.Pr = mappers.ToApiPullRequest(*prEntity)
+	resp.Headers.ETag = api.ETag(prEntity.Version)
+	return resp, nil
+}
+
+func (h *Handler) GetPullRequestGet(ctx context.Context, request api.GetPullRequestGetRequestObject) (api.GetPullRequestGetResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	prEntity, err := h.prService.Get(serviceCtx, request.Params.PullRequestId)
+	if err != nil {
+		if errors.Is(err, pullrequest.ErrPullRequestNotFound) {
+			return api.GetPullRequestGet404JSONResponse{}, nil
+		}
+
+		return api.GetPullRequestGet500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetPullRequestGet200JSONResponse{
+		Body:    mappers.ToApiPullRequest(*prEntity),
+		Headers: api.GetPullRequestGet200ResponseHeaders{ETag: api.ETag(prEntity.Version)},
 	}, nil
 }
 
@@ -0,0 +1,47 @@
+package stats
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+var cycleTimeCSVHeader = []string{
+	"key", "week_start", "merged",
+	"cycle_p50_seconds", "cycle_p90_seconds", "cycle_p99_seconds",
+	"first_review_p50_seconds", "first_review_p90_seconds", "first_review_p99_seconds",
+}
+
+// writeCycleTimeCSV пишет корзины в CSV; перцентили first_review остаются
+// пустыми, если решений ревьюверов не было.
+func writeCycleTimeCSV(w io.Writer, buckets []entity.CycleTimeBucket) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(cycleTimeCSVHeader); err != nil {
+		return err
+	}
+
+	for _, b := range buckets {
+		record := []string{b.Key, b.WeekStart.Format(time.RFC3339), strconv.Itoa(b.Merged)}
+		record = append(record, percentileFields(&b.CycleTime)...)
+		record = append(record, percentileFields(b.FirstReview)...)
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func percentileFields(p *entity.Percentiles) []string {
+	if p == nil {
+		return []string{"", "", ""}
+	}
+
+	format := func(d time.Duration) string {
+		return strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+	}
+	return []string{format(p.P50), format(p.P90), format(p.P99)}
+}
@@ -0,0 +1,138 @@
+package stats
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/mappers"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/stats"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+type Handler struct {
+	statsService service.Stats
+}
+
+func NewHandler(statsService service.Stats) *Handler {
+	return &Handler{
+		statsService: statsService,
+	}
+}
+
+func (h *Handler) GetStatsReviewers(ctx context.Context, request api.GetStatsReviewersRequestObject) (api.GetStatsReviewersResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	var teamName string
+	if request.Params.TeamName != nil {
+		teamName = *request.Params.TeamName
+	}
+
+	window, reviewers, err := h.statsService.ReviewerStats(serviceCtx,
+		mappers.ToEntityStatsWindow(request.Params.From, request.Params.To), teamName)
+	if err != nil {
+		if errors.Is(err, stats.ErrInvalidWindow) {
+			return api.GetStatsReviewers400JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to compute reviewer stats: %v", err)
+		return api.GetStatsReviewers500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetStatsReviewers200JSONResponse{
+		From:      window.From,
+		To:        window.To,
+		Reviewers: mappers.ToApiReviewerStats(reviewers),
+	}, nil
+}
+
+func (h *Handler) GetStatsTeams(ctx context.Context, request api.GetStatsTeamsRequestObject) (api.GetStatsTeamsResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	window, teams, err := h.statsService.TeamStats(serviceCtx,
+		mappers.ToEntityStatsWindow(request.Params.From, request.Params.To))
+	if err != nil {
+		if errors.Is(err, stats.ErrInvalidWindow) {
+			return api.GetStatsTeams400JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to compute team stats: %v", err)
+		return api.GetStatsTeams500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetStatsTeams200JSONResponse{
+		From:  window.From,
+		To:    window.To,
+		Teams: mappers.ToApiTeamStats(teams),
+	}, nil
+}
+
+func (h *Handler) GetStatsFairness(ctx context.Context, request api.GetStatsFairnessRequestObject) (api.GetStatsFairnessResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	params := request.Params
+	report, err := h.statsService.Fairness(serviceCtx, params.TeamName,
+		mappers.ToEntityStatsWindow(params.From, params.To), params.Tolerance)
+	if err != nil {
+		switch {
+		case errors.Is(err, stats.ErrInvalidWindow), errors.Is(err, stats.ErrInvalidTolerance):
+			return api.GetStatsFairness400JSONResponse{}, nil
+		case errors.Is(err, stats.ErrTeamNotFound):
+			return api.GetStatsFairness404JSONResponse{}, nil
+		default:
+			log.Printf("Handler: Failed to build fairness report: %v", err)
+			return api.GetStatsFairness500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	return api.GetStatsFairness200JSONResponse{
+		Report: mappers.ToApiFairnessReport(report),
+	}, nil
+}
+
+func (h *Handler) GetStatsCycleTime(ctx context.Context, request api.GetStatsCycleTimeRequestObject) (api.GetStatsCycleTimeResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	params := request.Params
+	group := entity.CycleTimeByTeam
+	if params.GroupBy != nil {
+		group = entity.CycleTimeGroup(*params.GroupBy)
+	}
+
+	window, buckets, err := h.statsService.CycleTime(serviceCtx, mappers.ToEntityStatsWindow(params.From, params.To), group)
+	if err != nil {
+		if errors.Is(err, stats.ErrInvalidWindow) || errors.Is(err, stats.ErrInvalidGroup) {
+			return api.GetStatsCycleTime400JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to compute cycle time: %v", err)
+		return api.GetStatsCycleTime500JSONResponse{}, api.ErrInternalServer
+	}
+
+	if params.Format != nil && *params.Format == api.Csv {
+		var buf bytes.Buffer
+		if err := writeCycleTimeCSV(&buf, buckets); err != nil {
+			log.Printf("Handler: Failed to encode cycle time csv: %v", err)
+			return api.GetStatsCycleTime500JSONResponse{}, api.ErrInternalServer
+		}
+
+		return api.GetStatsCycleTime200TextcsvResponse{
+			Body:          &buf,
+			ContentLength: int64(buf.Len()),
+		}, nil
+	}
+
+	return api.GetStatsCycleTime200JSONResponse{
+		From:    window.From,
+		To:      window.To,
+		GroupBy: string(group),
+		Buckets: mappers.ToApiCycleTimeBuckets(buckets),
+	}, nil
+}
@@ -0,0 +1,89 @@
+package stats_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/api/handlers/stats"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeStatsService struct {
+	buckets []entity.CycleTimeBucket
+}
+
+func (f *fakeStatsService) ReviewerStats(_ context.Context, w entity.StatsWindow, _ string) (entity.StatsWindow, []entity.ReviewerStats, error) {
+	return w, nil, nil
+}
+
+func (f *fakeStatsService) TeamStats(_ context.Context, w entity.StatsWindow) (entity.StatsWindow, []entity.TeamStats, error) {
+	return w, nil, nil
+}
+
+func (f *fakeStatsService) Fairness(context.Context, string, entity.StatsWindow, *float64) (entity.FairnessReport, error) {
+	return entity.FairnessReport{}, nil
+}
+
+func (f *fakeStatsService) CycleTime(_ context.Context, w entity.StatsWindow, _ entity.CycleTimeGroup) (entity.StatsWindow, []entity.CycleTimeBucket, error) {
+	return w, f.buckets, nil
+}
+
+func TestGetStatsCycleTime_CSV(t *testing.T) {
+	week := time.Date(2025, 11, 17, 0, 0, 0, 0, time.UTC)
+	svc := &fakeStatsService{buckets: []entity.CycleTimeBucket{
+		{
+			Key:       "backend",
+			WeekStart: week,
+			Merged:    2,
+			CycleTime: entity.Percentiles{P50: 90 * time.Minute, P90: 24 * time.Hour, P99: 48 * time.Hour},
+			FirstReview: &entity.Percentiles{
+				P50: 30 * time.Minute, P90: 2 * time.Hour, P99: 2 * time.Hour,
+			},
+		},
+		{Key: "payments", WeekStart: week, Merged: 1, CycleTime: entity.Percentiles{P50: time.Hour, P90: time.Hour, P99: time.Hour}},
+	}}
+	h := stats.NewHandler(svc)
+
+	format := api.Csv
+	resp, err := h.GetStatsCycleTime(context.Background(), api.GetStatsCycleTimeRequestObject{
+		Params: api.GetStatsCycleTimeParams{Format: &format},
+	})
+	if err != nil {
+		t.Fatalf("cycle time: %v", err)
+	}
+
+	csvResp, ok := resp.(api.GetStatsCycleTime200TextcsvResponse)
+	if !ok {
+		t.Fatalf("expected csv response, got %T", resp)
+	}
+	body, _ := io.ReadAll(csvResp.Body)
+
+	want := strings.Join([]string{
+		"key,week_start,merged,cycle_p50_seconds,cycle_p90_seconds,cycle_p99_seconds,first_review_p50_seconds,first_review_p90_seconds,first_review_p99_seconds",
+		"backend,2025-11-17T00:00:00Z,2,5400,86400,172800,1800,7200,7200",
+		"payments,2025-11-17T00:00:00Z,1,3600,3600,3600,,,",
+	}, "\n") + "\n"
+	if string(body) != want {
+		t.Fatalf("unexpected csv:\n%s", body)
+	}
+}
+
+func TestGetStatsCycleTime_DefaultsToTeamJSON(t *testing.T) {
+	h := stats.NewHandler(&fakeStatsService{})
+
+	resp, err := h.GetStatsCycleTime(context.Background(), api.GetStatsCycleTimeRequestObject{})
+	if err != nil {
+		t.Fatalf("cycle time: %v", err)
+	}
+
+	jsonResp, ok := resp.(api.GetStatsCycleTime200JSONResponse)
+	if !ok {
+		t.Fatalf("expected json response, got %T", resp)
+	}
+	if jsonResp.GroupBy != string(entity.CycleTimeByTeam) {
+		t.Fatalf("expected team grouping, got %s", jsonResp.GroupBy)
+	}
+}
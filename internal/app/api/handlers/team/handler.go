@@ -7,9 +7,21 @@ import (
 	"avito-backend-intern-assignment/internal/app/application/service/team"
 	"avito-backend-intern-assignment/internal/app/domain/entity"
 	"context"
+	"errors"
+	"mime"
 	"time"
 )
 
+// importTimeout больше обычного: импорт применяет весь файл одной транзакцией.
+const importTimeout = 30 * time.Second
+
+var importFormats = map[string]team.ImportFormat{
+	"text/csv":           team.ImportCSV,
+	"application/yaml":   team.ImportYAML,
+	"application/x-yaml": team.ImportYAML,
+	"text/yaml":          team.ImportYAML,
+}
+
 type Handler struct {
 	teamService service.Team
 }
@@ -34,7 +46,10 @@ func (h *Handler) GetTeamGet(ctx context.Context, request api.GetTeamGetRequestO
 		return api.GetTeamGet500JSONResponse{}, api.ErrInternalServer
 	}
 
-	return api.GetTeamGet200JSONResponse(mappers.ToApiTeam(t)), nil
+	return api.GetTeamGet200JSONResponse{
+		Body:    mappers.ToApiTeam(t),
+		Headers: api.GetTeamGet200ResponseHeaders{ETag: api.ETag(t.Version)},
+	}, nil
 }
 
 func (h *Handler) PostTeamAdd(ctx context.Context, request api.PostTeamAddRequestObject) (api.PostTeamAddResponseObject, error) {
@@ -42,12 +57,99 @@ func (h *Handler) PostTeamAdd(ctx context.Context, request api.PostTeamAddReques
 	defer cancel()
 
 	teamEntity := mappers.ToEntityTeam(*request.Body)
-	if err := h.teamService.CreateTeam(serviceCtx, teamEntity); err != nil {
-		return api.PostTeamAdd500JSONResponse{}, api.ErrInternalServer
+	serviceCtx = api.WithIfMatch(serviceCtx, request.Params.IfMatch, entity.AuditEntityTeam, teamEntity.TeamName)
+	saved, err := h.teamService.CreateTeam(serviceCtx, teamEntity)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrVersionMismatch):
+			return api.PostTeamAdd412JSONResponse(api.VersionMismatch()), nil
+		case errors.Is(err, team.ErrTeamAlreadyExists):
+			return api.PostTeamAdd400JSONResponse(teamExists()), nil
+		case errors.Is(err, team.ErrMemberConflict):
+			return api.PostTeamAdd409JSONResponse(memberConflict()), nil
+		default:
+			return api.PostTeamAdd500JSONResponse{}, api.ErrInternalServer
+		}
 	}
 
-	resp := api.PostTeamAdd201JSONResponse{
-		Team: request.Body,
-	}
+	var resp api.PostTeamAdd201JSONResponse
+	resp.Body.Team = request.Body
+	resp.Headers.ETag = api.ETag(saved.Version)
 	return resp, nil
 }
+
+func (h *Handler) PostTeamSetReviewSla(ctx context.Context, request api.PostTeamSetReviewSlaRequestObject) (api.PostTeamSetReviewSlaResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	sla := time.Duration(request.Body.ReviewSlaMinutes) * time.Minute
+	serviceCtx = api.WithIfMatch(serviceCtx, request.Params.IfMatch, entity.AuditEntityTeam, request.Body.TeamName)
+	if err := h.teamService.SetReviewSLA(serviceCtx, request.Body.TeamName, sla); err != nil {
+		switch {
+		case errors.Is(err, service.ErrVersionMismatch):
+			return api.PostTeamSetReviewSla412JSONResponse(api.VersionMismatch()), nil
+		case errors.Is(err, team.ErrInvalidReviewSLA):
+			return api.PostTeamSetReviewSla400JSONResponse{}, nil
+		case errors.Is(err, team.ErrTeamNotFound):
+			return api.PostTeamSetReviewSla404JSONResponse{}, nil
+		default:
+			return api.PostTeamSetReviewSla500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	return api.PostTeamSetReviewSla200Response{}, nil
+}
+
+func (h *Handler) PostTeamImport(ctx context.Context, request api.PostTeamImportRequestObject) (api.PostTeamImportResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, importTimeout)
+	defer cancel()
+
+	mediaType, _, _ := mime.ParseMediaType(request.ContentType)
+	format, ok := importFormats[mediaType]
+	if !ok {
+		return importError("Content-Type must be text/csv or application/yaml"), nil
+	}
+
+	teams, err := team.ParseImport(request.Body, format)
+	if err != nil {
+		return importError(err.Error()), nil
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+	plan, err := h.teamService.Import(serviceCtx, teams, dryRun)
+	if err != nil {
+		switch {
+		case errors.Is(err, team.ErrInvalidImport):
+			return importError(err.Error()), nil
+		case errors.Is(err, team.ErrTeamAlreadyExists):
+			return api.PostTeamImport409JSONResponse(teamExists()), nil
+		case errors.Is(err, team.ErrMemberConflict):
+			return api.PostTeamImport409JSONResponse(memberConflict()), nil
+		default:
+			return api.PostTeamImport500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	return api.PostTeamImport200JSONResponse(mappers.ToApiTeamImportPlan(plan)), nil
+}
+
+func importError(message string) api.PostTeamImport400JSONResponse {
+	var resp api.PostTeamImport400JSONResponse
+	resp.Error.Code = api.INVALIDREQUEST
+	resp.Error.Message = message
+	return resp
+}
+
+func teamExists() api.ErrorResponse {
+	var resp api.ErrorResponse
+	resp.Error.Code = api.TEAMEXISTS
+	resp.Error.Message = team.ErrTeamAlreadyExists.Error()
+	return resp
+}
+
+func memberConflict() api.ErrorResponse {
+	var resp api.ErrorResponse
+	resp.Error.Code = api.MEMBERCONFLICT
+	resp.Error.Message = team.ErrMemberConflict.Error()
+	return resp
+}
@@ -5,6 +5,7 @@ import (
 	"avito-backend-intern-assignment/internal/app/application/mappers"
 	"avito-backend-intern-assignment/internal/app/application/service"
 	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
 	"context"
 	"errors"
 	"time"
@@ -24,17 +25,41 @@ func (h *Handler) PostUsersSetIsActive(ctx context.Context, request api.PostUser
 	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
 	defer cancel()
 
+	serviceCtx = api.WithIfMatch(serviceCtx, request.Params.IfMatch, entity.AuditEntityUser, request.Body.UserId)
 	u, err := h.userService.SetIsActive(serviceCtx, request.Body.UserId, request.Body.IsActive)
 	if err != nil {
-		if errors.Is(err, user.ErrUserNotFound) {
+		switch {
+		case errors.Is(err, user.ErrUserNotFound):
 			return api.PostUsersSetIsActive404JSONResponse{}, nil
+		case errors.Is(err, service.ErrVersionMismatch):
+			return api.PostUsersSetIsActive412JSONResponse(api.VersionMismatch()), nil
 		}
 
 		return api.PostUsersSetIsActive500JSONResponse{}, nil
 	}
 
 	userDTO := mappers.ToApiUser(u)
-	return api.PostUsersSetIsActive200JSONResponse{
-		User: &userDTO,
+	var resp api.PostUsersSetIsActive200JSONResponse
+	resp.Body.User = &userDTO
+	resp.Headers.ETag = api.ETag(u.Version)
+	return resp, nil
+}
+
+func (h *Handler) GetUsersGet(ctx context.Context, request api.GetUsersGetRequestObject) (api.GetUsersGetResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	u, err := h.userService.Get(serviceCtx, request.Params.UserId)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return api.GetUsersGet404JSONResponse{}, nil
+		}
+
+		return api.GetUsersGet500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetUsersGet200JSONResponse{
+		Body:    mappers.ToApiUser(u),
+		Headers: api.GetUsersGet200ResponseHeaders{ETag: api.ETag(u.Version)},
 	}, nil
 }
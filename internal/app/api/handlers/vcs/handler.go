@@ -0,0 +1,60 @@
+package vcs
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/mappers"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/application/service/vcs"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+type Handler struct {
+	vcsService service.VCS
+}
+
+func NewHandler(vcsService service.VCS) *Handler {
+	return &Handler{
+		vcsService: vcsService,
+	}
+}
+
+func (h *Handler) PostVcsIdentitiesLink(ctx context.Context, request api.PostVcsIdentitiesLinkRequestObject) (api.PostVcsIdentitiesLinkResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	identity, err := h.vcsService.LinkIdentity(serviceCtx, mappers.ToEntityVCSIdentity(api.PostVcsIdentitiesLinkJSONBody(*request.Body)))
+	if err != nil {
+		switch {
+		case errors.Is(err, vcs.ErrInvalidIdentity):
+			return api.PostVcsIdentitiesLink400JSONResponse{}, nil
+		case errors.Is(err, user.ErrUserNotFound):
+			return api.PostVcsIdentitiesLink404JSONResponse{}, nil
+		default:
+			log.Printf("Handler: Failed to link VCS identity: %v", err)
+			return api.PostVcsIdentitiesLink500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	return api.PostVcsIdentitiesLink200JSONResponse{
+		Identity: mappers.ToApiVCSIdentity(identity),
+	}, nil
+}
+
+func (h *Handler) GetVcsIdentitiesList(ctx context.Context, request api.GetVcsIdentitiesListRequestObject) (api.GetVcsIdentitiesListResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	identities, err := h.vcsService.ListIdentities(serviceCtx, request.Params.UserId)
+	if err != nil {
+		log.Printf("Handler: Failed to list VCS identities: %v", err)
+		return api.GetVcsIdentitiesList500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetVcsIdentitiesList200JSONResponse{
+		Identities: mappers.ToApiVCSIdentities(identities),
+	}, nil
+}
@@ -0,0 +1,235 @@
+package vcs
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/vcs"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/pkg/requestctx"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+const (
+	GitHubSignatureHeader = "X-Hub-Signature-256"
+	GitHubEventHeader     = "X-GitHub-Event"
+	GitLabTokenHeader     = "X-Gitlab-Token"
+	GitLabEventHeader     = "X-Gitlab-Event"
+
+	maxPayloadBytes = 5 << 20
+)
+
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number int64  `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+	Sender struct {
+		Login string `json:"login"`
+	} `json:"sender"`
+}
+
+type gitlabMergeRequestPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID    int64  `json:"iid"`
+		Title  string `json:"title"`
+		Action string `json:"action"`
+	} `json:"object_attributes"`
+}
+
+type webhookResponse struct {
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Outcome       string `json:"outcome"`
+}
+
+// WebhookHandler принимает исходные вебхуки GitHub (pull_request) и GitLab
+// (merge_request). Подпись проверяется по сырому телу запроса, поэтому эти
+// маршруты не проходят через сгенерированный strict-обработчик.
+type WebhookHandler struct {
+	vcsService   service.VCS
+	githubSecret string
+	gitlabToken  string
+}
+
+func NewWebhookHandler(vcsService service.VCS, githubSecret, gitlabToken string) *WebhookHandler {
+	return &WebhookHandler{
+		vcsService:   vcsService,
+		githubSecret: githubSecret,
+		gitlabToken:  gitlabToken,
+	}
+}
+
+func (h *WebhookHandler) GitHub(w http.ResponseWriter, r *http.Request) {
+	if h.githubSecret == "" {
+		writeError(w, http.StatusNotFound, api.NOTFOUND, "github integration is not configured")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, api.INVALIDREQUEST, "failed to read payload")
+		return
+	}
+
+	if !verifyGitHubSignature(h.githubSecret, body, r.Header.Get(GitHubSignatureHeader)) {
+		writeError(w, http.StatusUnauthorized, api.UNAUTHORIZED, "invalid signature")
+		return
+	}
+
+	if r.Header.Get(GitHubEventHeader) != "pull_request" {
+		writeJSON(w, http.StatusOK, webhookResponse{Outcome: string(entity.VCSSyncIgnored)})
+		return
+	}
+
+	var payload githubPullRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, api.INVALIDREQUEST, "invalid payload")
+		return
+	}
+
+	action := entity.VCSActionOther
+	switch payload.Action {
+	case "opened":
+		action = entity.VCSActionOpened
+	case "reopened":
+		action = entity.VCSActionReopened
+	case "closed":
+		action = entity.VCSActionClosed
+		if payload.PullRequest.Merged {
+			action = entity.VCSActionMerged
+		}
+	}
+
+	h.handle(w, r, payload.Sender.Login, entity.VCSPullRequestEvent{
+		Provider:    entity.VCSProviderGitHub,
+		Action:      action,
+		Repository:  payload.Repository.FullName,
+		Number:      payload.PullRequest.Number,
+		Title:       payload.PullRequest.Title,
+		AuthorLogin: payload.PullRequest.User.Login,
+	})
+}
+
+func (h *WebhookHandler) GitLab(w http.ResponseWriter, r *http.Request) {
+	if h.gitlabToken == "" {
+		writeError(w, http.StatusNotFound, api.NOTFOUND, "gitlab integration is not configured")
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(r.Header.Get(GitLabTokenHeader)), []byte(h.gitlabToken)) != 1 {
+		writeError(w, http.StatusUnauthorized, api.UNAUTHORIZED, "invalid token")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, api.INVALIDREQUEST, "failed to read payload")
+		return
+	}
+
+	var payload gitlabMergeRequestPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, api.INVALIDREQUEST, "invalid payload")
+		return
+	}
+
+	if payload.ObjectKind != "merge_request" {
+		writeJSON(w, http.StatusOK, webhookResponse{Outcome: string(entity.VCSSyncIgnored)})
+		return
+	}
+
+	action := entity.VCSActionOther
+	switch payload.ObjectAttributes.Action {
+	case "open":
+		action = entity.VCSActionOpened
+	case "reopen":
+		action = entity.VCSActionReopened
+	case "close":
+		action = entity.VCSActionClosed
+	case "merge":
+		action = entity.VCSActionMerged
+	}
+
+	// для action=open инициатор события и есть автор merge request'а
+	h.handle(w, r, payload.User.Username, entity.VCSPullRequestEvent{
+		Provider:    entity.VCSProviderGitLab,
+		Action:      action,
+		Repository:  payload.Project.PathWithNamespace,
+		Number:      payload.ObjectAttributes.IID,
+		Title:       payload.ObjectAttributes.Title,
+		AuthorLogin: payload.User.Username,
+	})
+}
+
+func (h *WebhookHandler) handle(w http.ResponseWriter, r *http.Request, sender string, e entity.VCSPullRequestEvent) {
+	ctx, cancel := context.WithTimeout(r.Context(), 300*time.Millisecond)
+	defer cancel()
+	ctx = requestctx.WithActor(ctx, string(e.Provider)+":"+sender)
+
+	result, err := h.vcsService.HandlePullRequestEvent(ctx, e)
+	if err != nil {
+		// повтор такого события не поможет, поэтому это ошибка клиента, а не 500
+		switch {
+		case errors.Is(err, vcs.ErrIdentityNotMapped), errors.Is(err, pullrequest.ErrAuthorNotFound):
+			writeError(w, http.StatusUnprocessableEntity, api.NOTFOUND, err.Error())
+			return
+		case errors.Is(err, pullrequest.ErrNotEnoughReviewers):
+			writeError(w, http.StatusUnprocessableEntity, api.NOCANDIDATE, err.Error())
+			return
+		}
+
+		log.Printf("Handler: Failed to handle %s webhook: %v", e.Provider, err)
+		writeError(w, http.StatusInternalServerError, api.INTERNALSERVERERROR, "internal server error")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, webhookResponse{
+		PullRequestID: result.PullRequestID,
+		Outcome:       string(result.Outcome),
+	})
+}
+
+func verifyGitHubSignature(secret string, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func writeError(w http.ResponseWriter, status int, code api.ErrorResponseErrorCode, message string) {
+	var resp api.ErrorResponse
+	resp.Error.Code = code
+	resp.Error.Message = message
+	writeJSON(w, status, resp)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/mappers"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/webhook"
+	"context"
+	"errors"
+	"log"
+	"time"
+)
+
+type Handler struct {
+	webhookService service.Webhook
+}
+
+func NewHandler(webhookService service.Webhook) *Handler {
+	return &Handler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *Handler) PostWebhooksSubscribe(ctx context.Context, request api.PostWebhooksSubscribeRequestObject) (api.PostWebhooksSubscribeResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	sub, err := h.webhookService.Subscribe(serviceCtx, mappers.ToEntityWebhookSubscription(api.PostWebhooksSubscribeJSONBody(*request.Body)))
+	if err != nil {
+		switch {
+		case errors.Is(err, webhook.ErrInvalidURL), errors.Is(err, webhook.ErrInvalidEventTypes):
+			return api.PostWebhooksSubscribe400JSONResponse{}, nil
+		case errors.Is(err, team.ErrTeamNotFound):
+			return api.PostWebhooksSubscribe404JSONResponse{}, nil
+		default:
+			log.Printf("Handler: Failed to create webhook subscription: %v", err)
+			return api.PostWebhooksSubscribe500JSONResponse{}, api.ErrInternalServer
+		}
+	}
+
+	subDTO := mappers.ToApiWebhookSubscription(sub)
+	subDTO.Secret = &sub.Secret
+	return api.PostWebhooksSubscribe201JSONResponse{
+		Subscription: subDTO,
+	}, nil
+}
+
+func (h *Handler) GetWebhooksList(ctx context.Context, request api.GetWebhooksListRequestObject) (api.GetWebhooksListResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	subs, err := h.webhookService.ListSubscriptions(serviceCtx, request.Params.TeamName)
+	if err != nil {
+		log.Printf("Handler: Failed to list webhook subscriptions: %v", err)
+		return api.GetWebhooksList500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetWebhooksList200JSONResponse{
+		Subscriptions: mappers.ToApiWebhookSubscriptions(subs),
+	}, nil
+}
+
+func (h *Handler) PostWebhooksUnsubscribe(ctx context.Context, request api.PostWebhooksUnsubscribeRequestObject) (api.PostWebhooksUnsubscribeResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	if err := h.webhookService.Unsubscribe(serviceCtx, request.Body.SubscriptionId); err != nil {
+		if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+			return api.PostWebhooksUnsubscribe404JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to delete webhook subscription: %v", err)
+		return api.PostWebhooksUnsubscribe500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.PostWebhooksUnsubscribe200Response{}, nil
+}
+
+func (h *Handler) GetWebhooksDeliveries(ctx context.Context, request api.GetWebhooksDeliveriesRequestObject) (api.GetWebhooksDeliveriesResponseObject, error) {
+	serviceCtx, cancel := context.WithTimeout(ctx, 300*time.Millisecond)
+	defer cancel()
+
+	params := request.Params
+	if params.Limit != nil && (*params.Limit < 1 || *params.Limit > webhook.MaxDeliveriesLimit) {
+		return api.GetWebhooksDeliveries400JSONResponse{}, nil
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(serviceCtx, mappers.ToEntityWebhookDeliveryFilter(params))
+	if err != nil {
+		if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+			return api.GetWebhooksDeliveries404JSONResponse{}, nil
+		}
+
+		log.Printf("Handler: Failed to list webhook deliveries: %v", err)
+		return api.GetWebhooksDeliveries500JSONResponse{}, api.ErrInternalServer
+	}
+
+	return api.GetWebhooksDeliveries200JSONResponse{
+		Deliveries: mappers.ToApiWebhookDeliveries(deliveries),
+	}, nil
+}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/application/service/idempotency"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	maxIdempotentBodyBytes  = 1 << 20
+)
+
+// Idempotency сохраняет ответы на запросы с заголовком Idempotency-Key к
+// перечисленным маршрутам (например, "POST /pullRequest/create") и отдаёт их
+// при повторе вместо повторного выполнения. Ответы 5xx не сохраняются:
+// транзакция операции откатилась, и повтор должен выполнить её заново.
+func Idempotency(service *idempotency.Service, routes ...string) func(http.Handler) http.Handler {
+	enabled := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		enabled[route] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			route := r.Method + " " + r.URL.Path
+			if key == "" || !enabled[route] {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				writeError(w, http.StatusBadRequest, api.INVALIDREQUEST, "Idempotency-Key is too long")
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodyBytes))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, api.INVALIDREQUEST, "failed to read request body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			saved, token, err := service.Begin(r.Context(), route, key, fingerprint(r, body))
+			switch {
+			case errors.Is(err, idempotency.ErrKeyReused):
+				writeError(w, http.StatusUnprocessableEntity, api.IDEMPOTENCYKEYREUSED, err.Error())
+				return
+			case errors.Is(err, idempotency.ErrInProgress):
+				writeError(w, http.StatusConflict, api.IDEMPOTENCYKEYINPROGRESS, err.Error())
+				return
+			case err != nil:
+				log.Printf("ERROR: Failed to check idempotency key %q for %s: %v", key, route, err)
+				writeError(w, http.StatusInternalServerError, api.INTERNALSERVERERROR, "Internal server error")
+				return
+			case saved != nil:
+				if saved.ContentType != "" {
+					w.Header().Set("Content-Type", saved.ContentType)
+				}
+				w.Header().Set(IdempotentReplayedHeader, "true")
+				w.WriteHeader(saved.StatusCode)
+				_, _ = w.Write(saved.Body)
+				return
+			}
+
+			rec := &responseRecorder{ResponseWriter: w}
+			// ключ нужно освободить или закрыть, даже если клиент уже отключился
+			ctx := context.WithoutCancel(r.Context())
+			defer func() {
+				if p := recover(); p != nil {
+					release(ctx, service, route, key, token)
+					panic(p)
+				}
+			}()
+
+			next.ServeHTTP(rec, r)
+
+			if rec.status == 0 || rec.status >= http.StatusInternalServerError {
+				release(ctx, service, route, key, token)
+				return
+			}
+			if err := service.Complete(ctx, route, key, token, rec.status, rec.Header().Get("Content-Type"), rec.body.Bytes()); err != nil {
+				log.Printf("ERROR: Failed to save response for idempotency key %q: %v", key, err)
+			}
+		})
+	}
+}
+
+func release(ctx context.Context, service *idempotency.Service, route string, key string, token string) {
+	if err := service.Release(ctx, route, key, token); err != nil {
+		log.Printf("ERROR: Failed to release idempotency key %q: %v", key, err)
+	}
+}
+
+// fingerprintHeaders меняют смысл запроса: с другим If-Match тот же запрос
+// может завершиться иначе.
+var fingerprintHeaders = []string{"If-Match"}
+
+// fingerprint отличает повтор запроса от другого запроса с тем же ключом.
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.URL.RawQuery))
+	h.Write([]byte{0})
+	for _, name := range fingerprintHeaders {
+		h.Write([]byte(strings.Join(r.Header.Values(name), ",")))
+		h.Write([]byte{0})
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func writeError(w http.ResponseWriter, status int, code api.ErrorResponseErrorCode, message string) {
+	var resp api.ErrorResponse
+	resp.Error.Code = code
+	resp.Error.Message = message
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(resp)
+}
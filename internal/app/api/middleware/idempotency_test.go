@@ -0,0 +1,214 @@
+package middleware_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api/middleware"
+	"avito-backend-intern-assignment/internal/app/application/service/idempotency"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/memory"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const route = "/pullRequest/reassign"
+
+// newServer оборачивает счётчик вызовов: каждый выполненный запрос получает
+// новый номер в теле ответа, поэтому повтор легко отличить от выполнения.
+func newServer(status int) (http.Handler, *atomic.Int64) {
+	service := idempotency.NewService(memory.NewIdempotencyRepository(memdb.New()), idempotency.Config{
+		TTL:         time.Hour,
+		LockTimeout: time.Minute,
+	})
+
+	calls := &atomic.Int64{}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"call":%d,"echo":%q}`, calls.Add(1), body)
+	})
+
+	return middleware.Idempotency(service, "POST "+route)(next), calls
+}
+
+func post(h http.Handler, path, key, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if key != "" {
+		req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestIdempotency_ReplaysSavedResponse(t *testing.T) {
+	h, calls := newServer(http.StatusOK)
+
+	first := post(h, route, "k1", `{"pull_request_id":"pr-1"}`)
+	second := post(h, route, "k1", `{"pull_request_id":"pr-1"}`)
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", calls.Load())
+	}
+	if second.Code != first.Code || second.Body.String() != first.Body.String() {
+		t.Fatalf("expected replay of %d %s, got %d %s", first.Code, first.Body, second.Code, second.Body)
+	}
+	if second.Header().Get(middleware.IdempotentReplayedHeader) != "true" || first.Header().Get(middleware.IdempotentReplayedHeader) != "" {
+		t.Fatalf("only the replay must be marked, got %q and %q",
+			first.Header().Get(middleware.IdempotentReplayedHeader), second.Header().Get(middleware.IdempotentReplayedHeader))
+	}
+	if second.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("expected saved content type, got %q", second.Header().Get("Content-Type"))
+	}
+
+	if post(h, route, "k2", `{"pull_request_id":"pr-1"}`); calls.Load() != 2 {
+		t.Fatalf("another key must execute the request, calls=%d", calls.Load())
+	}
+}
+
+func TestIdempotency_RejectsKeyReuseWithDifferentBody(t *testing.T) {
+	h, calls := newServer(http.StatusOK)
+
+	post(h, route, "k1", `{"pull_request_id":"pr-1"}`)
+	rec := post(h, route, "k1", `{"pull_request_id":"pr-2"}`)
+
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_KEY_REUSED") {
+		t.Fatalf("expected 422 IDEMPOTENCY_KEY_REUSED, got %d %s", rec.Code, rec.Body)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", calls.Load())
+	}
+}
+
+func TestIdempotency_DoesNotSaveServerErrors(t *testing.T) {
+	h, calls := newServer(http.StatusInternalServerError)
+
+	post(h, route, "k1", `{}`)
+	rec := post(h, route, "k1", `{}`)
+
+	if calls.Load() != 2 || rec.Header().Get(middleware.IdempotentReplayedHeader) != "" {
+		t.Fatalf("5xx must not be replayed: calls=%d, replayed=%q", calls.Load(), rec.Header().Get(middleware.IdempotentReplayedHeader))
+	}
+}
+
+func TestIdempotency_InProgressKeyConflicts(t *testing.T) {
+	service := idempotency.NewService(memory.NewIdempotencyRepository(memdb.New()), idempotency.Config{
+		TTL:         time.Hour,
+		LockTimeout: time.Minute,
+	})
+	started, finish := make(chan struct{}), make(chan struct{})
+	h := middleware.Idempotency(service, "POST "+route)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-finish
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() { done <- post(h, route, "k1", `{}`) }()
+	<-started
+
+	rec := post(h, route, "k1", `{}`)
+	if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_KEY_IN_PROGRESS") {
+		t.Fatalf("expected 409 IDEMPOTENCY_KEY_IN_PROGRESS, got %d %s", rec.Code, rec.Body)
+	}
+
+	close(finish)
+	if first := <-done; first.Code != http.StatusOK {
+		t.Fatalf("expected first request to succeed, got %d", first.Code)
+	}
+	if rec := post(h, route, "k1", `{}`); rec.Code != http.StatusOK || rec.Header().Get(middleware.IdempotentReplayedHeader) != "true" {
+		t.Fatalf("expected replay after completion, got %d %v", rec.Code, rec.Header())
+	}
+}
+
+func TestIdempotency_IgnoresOtherRoutesAndRequestsWithoutKey(t *testing.T) {
+	h, calls := newServer(http.StatusOK)
+
+	post(h, route, "", `{}`)
+	post(h, route, "", `{}`)
+	post(h, "/pullRequest/merge", "k1", `{}`)
+	post(h, "/pullRequest/merge", "k1", `{}`)
+
+	if calls.Load() != 4 {
+		t.Fatalf("expected every request to run, calls=%d", calls.Load())
+	}
+}
+
+func TestIdempotency_IfMatchIsPartOfRequest(t *testing.T) {
+	h, calls := newServer(http.StatusOK)
+
+	send := func(ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, route, strings.NewReader(`{}`))
+		req.Header.Set(middleware.IdempotencyKeyHeader, "k1")
+		req.Header.Set("If-Match", ifMatch)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	send(`"1"`)
+	if rec := send(`"1"`); rec.Header().Get(middleware.IdempotentReplayedHeader) != "true" {
+		t.Fatalf("expected replay for the same If-Match, got %d %s", rec.Code, rec.Body)
+	}
+	rec := send(`"2"`)
+	if rec.Code != http.StatusUnprocessableEntity || !strings.Contains(rec.Body.String(), "IDEMPOTENCY_KEY_REUSED") {
+		t.Fatalf("expected 422 IDEMPOTENCY_KEY_REUSED, got %d %s", rec.Code, rec.Body)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected handler to run once, ran %d times", calls.Load())
+	}
+}
+
+// Запрос, чьё резервирование сочли зависшим и заняли повтором, не должен ни
+// сохранить свой ответ вместо ответа повтора, ни освободить чужой ключ.
+func TestIdempotency_StaleRequestKeepsTakeover(t *testing.T) {
+	service := idempotency.NewService(memory.NewIdempotencyRepository(memdb.New()), idempotency.Config{
+		TTL:         time.Hour,
+		LockTimeout: 0,
+	})
+
+	for _, staleStatus := range []int{http.StatusOK, http.StatusInternalServerError} {
+		key := fmt.Sprintf("k-%d", staleStatus)
+		var calls atomic.Int64
+		started := []chan struct{}{make(chan struct{}), make(chan struct{})}
+		finish := []chan struct{}{make(chan struct{}), make(chan struct{})}
+		h := middleware.Idempotency(service, "POST "+route)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			call := calls.Add(1)
+			status := http.StatusOK
+			if call <= 2 {
+				close(started[call-1])
+				<-finish[call-1]
+			}
+			if call == 1 {
+				status = staleStatus
+			}
+			w.WriteHeader(status)
+			fmt.Fprintf(w, `{"call":%d}`, call)
+		}))
+
+		stale, takeover := make(chan *httptest.ResponseRecorder), make(chan *httptest.ResponseRecorder)
+		go func() { stale <- post(h, route, key, `{}`) }()
+		<-started[0]
+		// LockTimeout 0: первое резервирование сразу считается зависшим
+		go func() { takeover <- post(h, route, key, `{}`) }()
+		<-started[1]
+
+		close(finish[0])
+		<-stale
+		close(finish[1])
+		if rec := <-takeover; rec.Code != http.StatusOK || rec.Body.String() != `{"call":2}` {
+			t.Fatalf("expected the retry to run, got %d %s", rec.Code, rec.Body)
+		}
+
+		replay := post(h, route, key, `{}`)
+		if replay.Header().Get(middleware.IdempotentReplayedHeader) != "true" || replay.Body.String() != `{"call":2}` {
+			t.Fatalf("stale request with %d must not touch the takeover, got %d %s", staleStatus, replay.Code, replay.Body)
+		}
+	}
+}
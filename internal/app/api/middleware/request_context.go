@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"avito-backend-intern-assignment/internal/pkg/requestctx"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ActorHeader     = "X-Actor"
+	RequestIDHeader = "X-Request-Id"
+
+	anonymousActor = "anonymous"
+)
+
+// RequestContext кладёт в контекст запроса инициатора (заголовок X-Actor) и
+// идентификатор запроса (X-Request-Id, генерируется при отсутствии).
+func RequestContext(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor := r.Header.Get(ActorHeader)
+		if actor == "" {
+			actor = anonymousActor
+		}
+
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		ctx := requestctx.WithActor(r.Context(), actor)
+		ctx = requestctx.WithRequestID(ctx, requestID)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,117 @@
+// Пакет реализует шину событий внутри процесса. Сервисы публикуют в неё события
+// после фиксации транзакции, а потоковые подписчики (SSE) получают их без опроса БД.
+package eventbus
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"log"
+	"slices"
+	"sync"
+)
+
+// Filter ограничивает поток событий; пустые поля не фильтруют.
+type Filter struct {
+	UserID   string
+	TeamName string
+}
+
+func (f Filter) Match(e event.Event) bool {
+	if f.TeamName != "" && e.Team() != f.TeamName {
+		return false
+	}
+	if f.UserID != "" && !slices.Contains(e.Users(), f.UserID) {
+		return false
+	}
+	return true
+}
+
+type Subscription struct {
+	events chan event.Event
+	filter Filter
+	bus    *Bus
+	once   sync.Once
+}
+
+// Events закрывается при отписке или остановке шины.
+func (s *Subscription) Events() <-chan event.Event {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.bus.unsubscribe(s)
+}
+
+type Bus struct {
+	mu         sync.RWMutex
+	subs       map[*Subscription]struct{}
+	bufferSize int
+	closed     bool
+}
+
+func New(bufferSize int) *Bus {
+	return &Bus{
+		subs:       make(map[*Subscription]struct{}),
+		bufferSize: bufferSize,
+	}
+}
+
+// Publish не блокируется: если подписчик не успевает вычитывать события и его
+// буфер заполнен, событие для него теряется.
+func (b *Bus) Publish(events ...event.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, e := range events {
+		for sub := range b.subs {
+			if !sub.filter.Match(e) {
+				continue
+			}
+			select {
+			case sub.events <- e:
+			default:
+				log.Printf("Event bus: dropping %s for slow subscriber", e.EventType())
+			}
+		}
+	}
+}
+
+func (b *Bus) Subscribe(filter Filter) *Subscription {
+	sub := &Subscription{
+		events: make(chan event.Event, b.bufferSize),
+		filter: filter,
+		bus:    b,
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(sub.events)
+		return sub
+	}
+	b.subs[sub] = struct{}{}
+
+	return sub
+}
+
+// Close отписывает всех подписчиков. Используется при остановке сервера, чтобы
+// долгоживущие соединения завершились.
+func (b *Bus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for sub := range b.subs {
+		delete(b.subs, sub)
+		sub.once.Do(func() { close(sub.events) })
+	}
+}
+
+func (b *Bus) unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subs, sub)
+	sub.once.Do(func() { close(sub.events) })
+}
+
+var _ event.Publisher = (*Bus)(nil)
@@ -0,0 +1,90 @@
+package eventbus_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"testing"
+	"time"
+)
+
+func receive(t *testing.T, sub *eventbus.Subscription) event.Event {
+	t.Helper()
+	select {
+	case e := <-sub.Events():
+		return e
+	case <-time.After(time.Second):
+		t.Fatal("event was not delivered")
+		return nil
+	}
+}
+
+func assertEmpty(t *testing.T, sub *eventbus.Subscription) {
+	t.Helper()
+	select {
+	case e := <-sub.Events():
+		t.Fatalf("unexpected event %s", e.EventType())
+	default:
+	}
+}
+
+func TestBus_FiltersByUserAndTeam(t *testing.T) {
+	bus := eventbus.New(4)
+	defer bus.Close()
+
+	byUser := bus.Subscribe(eventbus.Filter{UserID: "u2"})
+	byTeam := bus.Subscribe(eventbus.Filter{TeamName: "backend"})
+	all := bus.Subscribe(eventbus.Filter{})
+
+	bus.Publish(
+		event.ReviewerAssigned{PullRequestID: "pr-1", ReviewerID: "u2", TeamName: "backend"},
+		event.UserDeactivated{UserID: "u3", TeamName: "frontend"},
+	)
+
+	e := receive(t, byUser)
+	if e.EventType() != event.TypeReviewerAssigned {
+		t.Fatalf("expected %s, got %s", event.TypeReviewerAssigned, e.EventType())
+	}
+	assertEmpty(t, byUser)
+
+	e = receive(t, byTeam)
+	if e.Team() != "backend" {
+		t.Fatalf("expected backend event, got %s", e.Team())
+	}
+	assertEmpty(t, byTeam)
+
+	receive(t, all)
+	receive(t, all)
+}
+
+func TestBus_DropsForSlowSubscriber(t *testing.T) {
+	bus := eventbus.New(1)
+	defer bus.Close()
+
+	sub := bus.Subscribe(eventbus.Filter{})
+	bus.Publish(
+		event.UserDeactivated{UserID: "u1"},
+		event.UserDeactivated{UserID: "u2"},
+	)
+
+	e := receive(t, sub)
+	if users := e.Users(); len(users) != 1 || users[0] != "u1" {
+		t.Fatalf("expected first event to be kept, got %v", users)
+	}
+	assertEmpty(t, sub)
+}
+
+func TestBus_CloseEndsSubscriptions(t *testing.T) {
+	bus := eventbus.New(1)
+	sub := bus.Subscribe(eventbus.Filter{})
+
+	bus.Close()
+	if _, ok := <-sub.Events(); ok {
+		t.Fatal("expected subscription channel to be closed")
+	}
+
+	sub.Close()
+	late := bus.Subscribe(eventbus.Filter{})
+	if _, ok := <-late.Events(); ok {
+		t.Fatal("expected subscription after Close to be closed")
+	}
+}
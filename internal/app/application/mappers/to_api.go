@@ -3,6 +3,8 @@ package mappers
 import (
 	"avito-backend-intern-assignment/internal/app/api"
 	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"encoding/json"
+	"time"
 )
 
 func ToApiUser(u entity.User) api.User {
@@ -38,8 +40,55 @@ func ToApiTeam(t entity.Team) api.Team {
 	}
 }
 
+func toApiTeamImportChanges(changes []entity.TeamImportChange) []api.TeamImportChange {
+	result := make([]api.TeamImportChange, len(changes))
+	for i, c := range changes {
+		result[i] = api.TeamImportChange{
+			Kind:     api.TeamImportChangeKind(c.Kind),
+			TeamName: c.TeamName,
+		}
+		if c.UserID != "" {
+			result[i].UserId = &c.UserID
+		}
+		if c.FromTeamName != "" {
+			result[i].FromTeamName = &c.FromTeamName
+		}
+	}
+	return result
+}
+
+func ToApiTeamImportPlan(plan entity.TeamImportPlan) api.TeamImportPlan {
+	return api.TeamImportPlan{
+		Applied: plan.Applied,
+		Changes: toApiTeamImportChanges(plan.Changes),
+	}
+}
+
+func ToApiDirectorySyncReport(report entity.DirectorySyncReport) api.DirectorySyncReport {
+	reassigned := make([]api.DirectoryReassignment, len(report.Reassigned))
+	for i, r := range report.Reassigned {
+		reassigned[i] = api.DirectoryReassignment{
+			PullRequestId: r.PullRequestID,
+			OldReviewerId: r.OldReviewerID,
+		}
+		if r.NewReviewerID != "" {
+			reassigned[i].NewReviewerId = &r.NewReviewerID
+		}
+	}
+
+	return api.DirectorySyncReport{
+		Applied:     report.Applied,
+		InSync:      report.InSync(),
+		SyncedAt:    report.SyncedAt,
+		Changes:     toApiTeamImportChanges(report.Changes),
+		Deactivated: append([]string{}, report.Deactivated...),
+		Reassigned:  reassigned,
+		Unresolved:  append([]string{}, report.Unresolved...),
+	}
+}
+
 func ToApiPullRequest(pr entity.PullRequest) api.PullRequest {
-	return api.PullRequest{
+	result := api.PullRequest{
 		AssignedReviewers: pr.AssignedReviewers,
 		AuthorId:          pr.AuthorId,
 		CreatedAt:         pr.CreatedAt,
@@ -47,7 +96,37 @@ func ToApiPullRequest(pr entity.PullRequest) api.PullRequest {
 		PullRequestId:     pr.PullRequestId,
 		PullRequestName:   pr.PullRequestName,
 		Status:            api.PullRequestStatus(pr.Status),
+		SlaStatus:         toApiSLAStatus(pr.SLAStatus),
+	}
+	if len(pr.Reviews) > 0 {
+		reviews := toApiReviewAssignments(pr.Reviews)
+		result.Reviews = &reviews
 	}
+	return result
+}
+
+func toApiReviewAssignments(reviews []entity.ReviewAssignment) []api.ReviewAssignment {
+	result := make([]api.ReviewAssignment, len(reviews))
+	for i, r := range reviews {
+		result[i] = api.ReviewAssignment{
+			AssignedAt: r.AssignedAt,
+			DecidedAt:  r.DecidedAt,
+			ReviewerId: r.ReviewerID,
+		}
+		if r.Decision != "" {
+			decision := api.ReviewDecision(r.Decision)
+			result[i].Decision = &decision
+		}
+	}
+	return result
+}
+
+func toApiSLAStatus(status entity.SLAStatus) *api.SLAStatus {
+	if status == "" {
+		return nil
+	}
+	result := api.SLAStatus(status)
+	return &result
 }
 
 func ToApiPullRequests(prs []entity.PullRequest) []api.PullRequest {
@@ -64,6 +143,7 @@ func ToApiPullRequestShort(pr entity.PullRequest) api.PullRequestShort {
 		PullRequestId:   pr.PullRequestId,
 		PullRequestName: pr.PullRequestName,
 		Status:          api.PullRequestShortStatus(pr.Status),
+		SlaStatus:       toApiSLAStatus(pr.SLAStatus),
 	}
 }
 
@@ -74,3 +154,213 @@ func ToApiPullRequestsShort(prs []entity.PullRequest) []api.PullRequestShort {
 	}
 	return result
 }
+
+func ToApiAuditEvent(e entity.AuditEvent) api.AuditEvent {
+	return api.AuditEvent{
+		Action:     string(e.Action),
+		Actor:      e.Actor,
+		After:      toApiSnapshot(e.After),
+		Before:     toApiSnapshot(e.Before),
+		EntityId:   e.EntityID,
+		EntityType: api.AuditEventEntityType(e.EntityType),
+		Id:         e.ID,
+		OccurredAt: e.OccurredAt,
+		RequestId:  e.RequestID,
+	}
+}
+
+func ToApiAuditEvents(events []entity.AuditEvent) []api.AuditEvent {
+	result := make([]api.AuditEvent, len(events))
+	for i, e := range events {
+		result[i] = ToApiAuditEvent(e)
+	}
+	return result
+}
+
+func toApiSnapshot(data json.RawMessage) *map[string]any {
+	if len(data) == 0 {
+		return nil
+	}
+
+	var snapshot map[string]any
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil
+	}
+	return &snapshot
+}
+
+// ToApiWebhookSubscription не заполняет секрет: он отдаётся только при создании подписки.
+func ToApiWebhookSubscription(sub entity.WebhookSubscription) api.WebhookSubscription {
+	return api.WebhookSubscription{
+		CreatedAt:  sub.CreatedAt,
+		EventTypes: sub.EventTypes,
+		Id:         sub.ID,
+		TeamName:   sub.TeamName,
+		Url:        sub.URL,
+	}
+}
+
+func ToApiWebhookSubscriptions(subs []entity.WebhookSubscription) []api.WebhookSubscription {
+	result := make([]api.WebhookSubscription, len(subs))
+	for i, sub := range subs {
+		result[i] = ToApiWebhookSubscription(sub)
+	}
+	return result
+}
+
+func ToApiWebhookDelivery(d entity.WebhookDelivery) api.WebhookDelivery {
+	return api.WebhookDelivery{
+		Attempts:       d.Attempts,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+		EventId:        d.OutboxMessageID,
+		EventType:      d.EventType,
+		Id:             d.ID,
+		LastError:      d.LastError,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		Status:         api.WebhookDeliveryStatus(d.Status),
+		SubscriptionId: d.SubscriptionID,
+	}
+}
+
+func ToApiWebhookDeliveries(deliveries []entity.WebhookDelivery) []api.WebhookDelivery {
+	result := make([]api.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = ToApiWebhookDelivery(d)
+	}
+	return result
+}
+
+func ToApiVCSIdentity(identity entity.VCSIdentity) api.VCSIdentity {
+	return api.VCSIdentity{
+		CreatedAt: identity.CreatedAt,
+		Login:     identity.Login,
+		Provider:  api.VCSIdentityProvider(identity.Provider),
+		UserId:    identity.UserID,
+	}
+}
+
+func ToApiVCSIdentities(identities []entity.VCSIdentity) []api.VCSIdentity {
+	result := make([]api.VCSIdentity, len(identities))
+	for i, identity := range identities {
+		result[i] = ToApiVCSIdentity(identity)
+	}
+	return result
+}
+
+func ToApiTeamNotificationSettings(settings entity.TeamNotificationSettings) api.TeamNotificationSettings {
+	result := api.TeamNotificationSettings{
+		Mode:     api.NotificationMode(settings.Mode),
+		TeamName: settings.TeamName,
+	}
+	if settings.ChatWebhookURL != "" {
+		result.ChatWebhookUrl = &settings.ChatWebhookURL
+	}
+	return result
+}
+
+func ToApiUserNotificationPreferences(prefs entity.UserNotificationPreferences) api.UserNotificationPreferences {
+	result := api.UserNotificationPreferences{
+		Mode:   api.NotificationMode(prefs.Mode),
+		UserId: prefs.UserID,
+	}
+	if prefs.Email != "" {
+		result.Email = &prefs.Email
+	}
+	return result
+}
+
+func toApiMedianSeconds(d *time.Duration) *float64 {
+	if d == nil {
+		return nil
+	}
+	seconds := d.Seconds()
+	return &seconds
+}
+
+func ToApiReviewerStats(stats []entity.ReviewerStats) []api.ReviewerStats {
+	result := make([]api.ReviewerStats, len(stats))
+	for i, s := range stats {
+		result[i] = api.ReviewerStats{
+			UserId:                   s.UserID,
+			Username:                 s.Username,
+			TeamName:                 s.TeamName,
+			OpenAssignments:          s.OpenAssignments,
+			TotalAssignments:         s.TotalAssignments,
+			ReassignedAway:           s.ReassignedAway,
+			MergedReviewed:           s.MergedReviewed,
+			MedianTimeToMergeSeconds: toApiMedianSeconds(s.MedianTimeToMerge),
+		}
+	}
+	return result
+}
+
+func ToApiTeamStats(stats []entity.TeamStats) []api.TeamStats {
+	result := make([]api.TeamStats, len(stats))
+	for i, s := range stats {
+		result[i] = api.TeamStats{
+			TeamName:                 s.TeamName,
+			OpenAssignments:          s.OpenAssignments,
+			TotalAssignments:         s.TotalAssignments,
+			ReassignedAway:           s.ReassignedAway,
+			MergedReviewed:           s.MergedReviewed,
+			MedianTimeToMergeSeconds: toApiMedianSeconds(s.MedianTimeToMerge),
+		}
+	}
+	return result
+}
+
+func ToApiFairnessReport(report entity.FairnessReport) api.FairnessReport {
+	members := make([]api.FairnessMember, len(report.Members))
+	for i, m := range report.Members {
+		members[i] = api.FairnessMember{
+			UserId:          m.UserID,
+			Username:        m.Username,
+			IsActive:        m.IsActive,
+			ActiveDays:      m.ActiveDays,
+			Assignments:     m.Assignments,
+			Share:           m.Share,
+			IdealShare:      m.IdealShare,
+			Deviation:       m.Deviation,
+			WithinTolerance: m.WithinTolerance,
+		}
+	}
+
+	return api.FairnessReport{
+		TeamName:         report.TeamName,
+		From:             report.Window.From,
+		To:               report.Window.To,
+		Tolerance:        report.Tolerance,
+		TotalAssignments: report.TotalAssignments,
+		Gini:             report.Gini,
+		MaxMinRatio:      report.MaxMinRatio,
+		Members:          members,
+		Outliers:         report.Outliers,
+	}
+}
+
+func toApiPercentiles(p entity.Percentiles) api.Percentiles {
+	return api.Percentiles{
+		P50Seconds: p.P50.Seconds(),
+		P90Seconds: p.P90.Seconds(),
+		P99Seconds: p.P99.Seconds(),
+	}
+}
+
+func ToApiCycleTimeBuckets(buckets []entity.CycleTimeBucket) []api.CycleTimeBucket {
+	result := make([]api.CycleTimeBucket, len(buckets))
+	for i, b := range buckets {
+		result[i] = api.CycleTimeBucket{
+			Key:       b.Key,
+			WeekStart: b.WeekStart,
+			Merged:    b.Merged,
+			CycleTime: toApiPercentiles(b.CycleTime),
+		}
+		if b.FirstReview != nil {
+			firstReview := toApiPercentiles(*b.FirstReview)
+			result[i].FirstReview = &firstReview
+		}
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package mappers
 import (
 	"avito-backend-intern-assignment/internal/app/api"
 	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"time"
 )
 
 func ToEntityUser(u api.User) entity.User {
@@ -64,3 +65,98 @@ func ToEntityPullRequestCreate(prReq api.PostPullRequestCreateJSONBody) entity.P
 		PullRequestName: prReq.PullRequestName,
 	}
 }
+
+func ToEntityAuditFilter(params api.GetAuditListParams) entity.AuditFilter {
+	filter := entity.AuditFilter{
+		From: params.From,
+		To:   params.To,
+	}
+	if params.Actor != nil {
+		filter.Actor = *params.Actor
+	}
+	if params.Action != nil {
+		filter.Action = entity.AuditAction(*params.Action)
+	}
+	if params.EntityType != nil {
+		filter.EntityType = string(*params.EntityType)
+	}
+	if params.EntityId != nil {
+		filter.EntityID = *params.EntityId
+	}
+	if params.RequestId != nil {
+		filter.RequestID = *params.RequestId
+	}
+	if params.Limit != nil {
+		filter.Limit = uint64(*params.Limit)
+	}
+	if params.Offset != nil {
+		filter.Offset = uint64(*params.Offset)
+	}
+	return filter
+}
+
+func ToEntityWebhookSubscription(req api.PostWebhooksSubscribeJSONBody) entity.WebhookSubscription {
+	sub := entity.WebhookSubscription{
+		EventTypes: req.EventTypes,
+		TeamName:   req.TeamName,
+		URL:        req.Url,
+	}
+	if req.Secret != nil {
+		sub.Secret = *req.Secret
+	}
+	return sub
+}
+
+func ToEntityWebhookDeliveryFilter(params api.GetWebhooksDeliveriesParams) entity.WebhookDeliveryFilter {
+	filter := entity.WebhookDeliveryFilter{
+		SubscriptionID: params.SubscriptionId,
+	}
+	if params.Status != nil {
+		filter.Status = entity.WebhookDeliveryStatus(*params.Status)
+	}
+	if params.Limit != nil {
+		filter.Limit = uint64(*params.Limit)
+	}
+	return filter
+}
+
+func ToEntityVCSIdentity(req api.PostVcsIdentitiesLinkJSONBody) entity.VCSIdentity {
+	return entity.VCSIdentity{
+		Login:    req.Login,
+		Provider: entity.VCSProvider(req.Provider),
+		UserID:   req.UserId,
+	}
+}
+
+func ToEntityTeamNotificationSettings(req api.TeamNotificationSettings) entity.TeamNotificationSettings {
+	settings := entity.TeamNotificationSettings{
+		Mode:     entity.NotificationMode(req.Mode),
+		TeamName: req.TeamName,
+	}
+	if req.ChatWebhookUrl != nil {
+		settings.ChatWebhookURL = *req.ChatWebhookUrl
+	}
+	return settings
+}
+
+func ToEntityUserNotificationPreferences(req api.UserNotificationPreferences) entity.UserNotificationPreferences {
+	prefs := entity.UserNotificationPreferences{
+		Mode:   entity.NotificationMode(req.Mode),
+		UserID: req.UserId,
+	}
+	if req.Email != nil {
+		prefs.Email = *req.Email
+	}
+	return prefs
+}
+
+func ToEntityStatsWindow(from, to *time.Time) entity.StatsWindow {
+	var window entity.StatsWindow
+	if from != nil {
+		window.From = *from
+	}
+	if to != nil {
+		window.To = *to
+	}
+	return window
+}
@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/pkg/requestctx"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultListLimit = 100
+	MaxListLimit     = 1000
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	Create(ctx context.Context, event entity.AuditEvent) error
+	List(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error)
+}
+
+type Service struct {
+	auditRepo Repository
+}
+
+func NewService(auditRepo Repository) *Service {
+	return &Service{
+		auditRepo: auditRepo,
+	}
+}
+
+func (s *Service) List(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	if filter.Limit == 0 {
+		filter.Limit = DefaultListLimit
+	}
+	if filter.Limit > MaxListLimit {
+		filter.Limit = MaxListLimit
+	}
+
+	events, err := s.auditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list audit events: %w", err)
+	}
+
+	return events, nil
+}
+
+// Record сохраняет событие аудита через переданный репозиторий. Вызывать его
+// нужно с ctx транзакции мутации (db.WithTx): репозиторий сам пишет в
+// транзакцию из ctx, поэтому запись в журнал и изменение фиксируются атомарно.
+// before/after — снимки сущности до и после изменения, nil означает отсутствие.
+func Record(ctx context.Context, repo Repository, action entity.AuditAction, entityType, entityID string, before, after any) error {
+	beforeJSON, err := snapshot(before)
+	if err != nil {
+		return fmt.Errorf("marshal before snapshot: %w", err)
+	}
+	afterJSON, err := snapshot(after)
+	if err != nil {
+		return fmt.Errorf("marshal after snapshot: %w", err)
+	}
+
+	event := entity.AuditEvent{
+		OccurredAt: time.Now().UTC(),
+		Actor:      requestctx.Actor(ctx),
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Before:     beforeJSON,
+		After:      afterJSON,
+		RequestID:  requestctx.RequestID(ctx),
+	}
+
+	if err := repo.Create(ctx, event); err != nil {
+		return fmt.Errorf("create audit event: %w", err)
+	}
+
+	return nil
+}
+
+func snapshot(v any) (json.RawMessage, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	// nil-указатель на сущность маршалится в null — храним его как NULL
+	if string(data) == "null" {
+		return nil, nil
+	}
+
+	return data, nil
+}
@@ -0,0 +1,311 @@
+package directory
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+)
+
+// Source — внешний каталог сотрудников, например SCIM 2.0 сервис.
+type Source interface {
+	Fetch(ctx context.Context) (entity.Directory, error)
+}
+
+var ErrSyncDisabled = errors.New("directory sync is not configured")
+
+type Config struct {
+	// MaxDeactivations — сколько пользователей синхронизация может
+	// деактивировать за раз без force; 0 снимает ограничение. Больше — скорее
+	// сбой каталога или урезанный токен, чем реальные увольнения.
+	MaxDeactivations int
+}
+
+type Service struct {
+	source      Source
+	userRepo    user.Repository
+	teamService service.Team
+	userService service.User
+	prService   service.PullRequest
+	txProvider  db.Transactional
+	cfg         Config
+}
+
+// NewService принимает nil source, если каталог не настроен: тогда Sync
+// возвращает ErrSyncDisabled.
+func NewService(
+	source Source,
+	userRepo user.Repository,
+	teamService service.Team,
+	userService service.User,
+	prService service.PullRequest,
+	txProvider db.Transactional,
+	cfg Config,
+) *Service {
+	return &Service{
+		source:      source,
+		userRepo:    userRepo,
+		teamService: teamService,
+		userService: userService,
+		prService:   prService,
+		txProvider:  txProvider,
+		cfg:         cfg,
+	}
+}
+
+// Sync приводит команды и пользователей к состоянию каталога: группы становятся
+// командами, участники создаются или переводятся, сотрудники, выключенные или
+// пропавшие из каталога, деактивируются, а их открытые ревью переназначаются.
+// Структура команд применяется одной транзакцией через импорт, деактивация и
+// переназначение ревью каждого ушедшего — своей; при сбое оставшееся
+// доделывается следующим запуском. При dryRun ничего
+// не меняется, отчёт показывает текущее расхождение.
+//
+// Пустой каталог не применяется никогда, а деактивация больше
+// Config.MaxDeactivations пользователей — только с force: вместо этого Sync
+// работает как dryRun и пишет причину в Unresolved.
+func (s *Service) Sync(ctx context.Context, dryRun, force bool) (entity.DirectorySyncReport, error) {
+	if s.source == nil {
+		return entity.DirectorySyncReport{}, ErrSyncDisabled
+	}
+
+	dir, err := s.source.Fetch(ctx)
+	if err != nil {
+		return entity.DirectorySyncReport{}, fmt.Errorf("fetch directory: %w", err)
+	}
+
+	local, err := s.userRepo.List(ctx)
+	if err != nil {
+		return entity.DirectorySyncReport{}, fmt.Errorf("list users: %w", err)
+	}
+
+	report := entity.DirectorySyncReport{SyncedAt: time.Now().UTC(), Changes: []entity.TeamImportChange{}}
+	teams, listed, unresolved := desiredTeams(dir)
+	report.Unresolved = unresolved
+
+	imported := make(map[string]bool)
+	for _, t := range teams {
+		for _, m := range t.Members {
+			imported[m.UserId] = true
+		}
+	}
+
+	if !dryRun {
+		if refusal := s.refusal(dir, local, listed, imported, force); refusal != "" {
+			report.Unresolved = append(report.Unresolved, refusal)
+			dryRun = true
+		}
+	}
+
+	if len(teams) > 0 {
+		plan, err := s.teamService.Import(ctx, teams, dryRun)
+		if err != nil {
+			return entity.DirectorySyncReport{}, fmt.Errorf("import teams: %w", err)
+		}
+		report.Changes = plan.Changes
+	}
+
+	// listed содержит всех, кого каталог знает; остальные активные ушли из компании.
+	// Выключенных в каталоге участников групп деактивирует импорт, а выключенных
+	// вне групп — этот цикл.
+	for _, u := range local {
+		if desired, ok := listed[u.UserId]; ok && desired {
+			continue
+		}
+		deactivate := leaving(u, listed, imported)
+		if deactivate {
+			report.Deactivated = append(report.Deactivated, u.UserId)
+		}
+
+		reassigned, problems, err := s.offboard(ctx, u.UserId, deactivate, dryRun)
+		if err != nil {
+			return report, err
+		}
+		report.Reassigned = append(report.Reassigned, reassigned...)
+		report.Unresolved = append(report.Unresolved, problems...)
+	}
+
+	report.Applied = !dryRun
+	return report, nil
+}
+
+// leaving сообщает, что активного пользователя нужно деактивировать: его нет
+// в каталоге или он выключен там и не входит ни в одну группу.
+func leaving(u entity.User, listed, imported map[string]bool) bool {
+	if desired, ok := listed[u.UserId]; ok && desired {
+		return false
+	}
+	return u.IsActive && !imported[u.UserId]
+}
+
+// refusal возвращает причину не применять синхронизацию или пустую строку.
+func (s *Service) refusal(dir entity.Directory, local []entity.User, listed, imported map[string]bool, force bool) string {
+	if len(dir.Users) == 0 {
+		return "directory returned no users, sync is not applied"
+	}
+	if force || s.cfg.MaxDeactivations <= 0 {
+		return ""
+	}
+
+	var count int
+	for _, u := range local {
+		if leaving(u, listed, imported) {
+			count++
+		}
+	}
+	if count > s.cfg.MaxDeactivations {
+		return fmt.Sprintf("sync would deactivate %d users, more than the limit of %d; sync is not applied, force it if this is expected",
+			count, s.cfg.MaxDeactivations)
+	}
+	return ""
+}
+
+// desiredTeams строит состав команд по группам каталога. listed отображает
+// идентификатор каждого известного каталогу пользователя в его активность,
+// включая тех, кого нельзя однозначно отнести к команде.
+func desiredTeams(dir entity.Directory) ([]entity.Team, map[string]bool, []string) {
+	byID := make(map[string]entity.DirectoryUser, len(dir.Users))
+	listed := make(map[string]bool, len(dir.Users))
+	var unresolved []string
+	for _, u := range dir.Users {
+		if u.UserName == "" {
+			unresolved = append(unresolved, fmt.Sprintf("directory user %s has no userName", u.ID))
+			continue
+		}
+		byID[u.ID] = u
+		listed[u.UserName] = u.Active
+	}
+
+	memberOf := make(map[string][]string)
+	for _, g := range dir.Groups {
+		for _, id := range g.MemberIDs {
+			if _, ok := byID[id]; ok {
+				memberOf[id] = append(memberOf[id], g.DisplayName)
+			}
+		}
+	}
+
+	var teams []entity.Team
+	for _, g := range dir.Groups {
+		if g.DisplayName == "" {
+			unresolved = append(unresolved, fmt.Sprintf("directory group %s has no displayName", g.ID))
+			continue
+		}
+
+		team := entity.Team{TeamName: g.DisplayName, Members: []entity.TeamMember{}}
+		for _, id := range g.MemberIDs {
+			u, ok := byID[id]
+			if !ok || len(memberOf[id]) > 1 {
+				continue
+			}
+			username := u.DisplayName
+			if username == "" {
+				username = u.UserName
+			}
+			team.Members = append(team.Members, entity.TeamMember{
+				UserId:   u.UserName,
+				Username: username,
+				IsActive: u.Active,
+			})
+		}
+		teams = append(teams, team)
+	}
+
+	for _, u := range dir.Users {
+		if u.UserName == "" {
+			continue
+		}
+		switch groups := memberOf[u.ID]; {
+		case len(groups) > 1:
+			slices.Sort(groups)
+			unresolved = append(unresolved, fmt.Sprintf("user %s belongs to several groups: %v", u.UserName, groups))
+		case len(groups) == 0 && u.Active:
+			unresolved = append(unresolved, fmt.Sprintf("user %s does not belong to any group", u.UserName))
+		}
+	}
+
+	return teams, listed, unresolved
+}
+
+// offboard деактивирует пользователя, если нужно, и снимает его с открытых
+// ревью в одной транзакции: пользователь не остаётся выключенным, но с
+// назначенными ревью. Сервисы пользователей и PR выполняют свои транзакции
+// как точки сохранения внутри неё.
+func (s *Service) offboard(ctx context.Context, userID string, deactivate, dryRun bool) ([]entity.DirectoryReassignment, []string, error) {
+	if dryRun {
+		return s.releaseReviews(ctx, userID, true)
+	}
+
+	var reassigned []entity.DirectoryReassignment
+	var problems []string
+	err := db.WithTxRetry(ctx, s.txProvider, pullrequest.AssignmentTxOptions, db.DefaultRetryPolicy, func(ctx context.Context, _ db.Tx) error {
+		if deactivate {
+			if _, err := s.userService.SetIsActive(ctx, userID, false); err != nil {
+				return fmt.Errorf("deactivate user %s: %w", userID, err)
+			}
+		}
+
+		var err error
+		reassigned, problems, err = s.releaseReviews(ctx, userID, false)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reassigned, problems, nil
+}
+
+// releaseReviews снимает неактивного пользователя с открытых PR. PR, для
+// которых в команде нет замены, попадают в нерешённые расхождения.
+func (s *Service) releaseReviews(ctx context.Context, userID string, dryRun bool) ([]entity.DirectoryReassignment, []string, error) {
+	_, prs, err := s.prService.GetPRsByReviewer(ctx, userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get reviews of %s: %w", userID, err)
+	}
+
+	var reassigned []entity.DirectoryReassignment
+	var problems []string
+	for _, pr := range prs {
+		if pr.Status != entity.PullRequestStatusOPEN {
+			continue
+		}
+
+		r := entity.DirectoryReassignment{PullRequestID: pr.PullRequestId, OldReviewerID: userID}
+		if !dryRun {
+			_, newReviewerID, err := s.prService.ReassignReviewer(ctx, pr.PullRequestId, userID)
+			switch {
+			case errors.Is(err, pullrequest.ErrReassignViolation), errors.Is(err, pullrequest.ErrNotEnoughReviewers):
+				problems = append(problems, fmt.Sprintf("no replacement for %s on PR %s", userID, pr.PullRequestId))
+				continue
+			case err != nil:
+				return nil, nil, fmt.Errorf("reassign %s on PR %s: %w", userID, pr.PullRequestId, err)
+			}
+			r.NewReviewerID = newReviewerID
+		}
+		reassigned = append(reassigned, r)
+	}
+
+	return reassigned, problems, nil
+}
+
+// RunScheduled — задача планировщика: применяет синхронизацию и пишет итог в лог.
+func (s *Service) RunScheduled(ctx context.Context, _ db.Tx) error {
+	report, err := s.Sync(ctx, false, false)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Scheduler: directory sync: %d change(s), %d deactivated, %d review(s) reassigned, %d unresolved",
+		len(report.Changes), len(report.Deactivated), len(report.Reassigned), len(report.Unresolved))
+	for _, problem := range report.Unresolved {
+		log.Printf("Scheduler: directory drift: %s", problem)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package directory_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/directory"
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim/scimtest"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+)
+
+type fakeSource struct{ dir entity.Directory }
+
+func (s fakeSource) Fetch(context.Context) (entity.Directory, error) { return s.dir, nil }
+
+// фейки встраивают интерфейсы, поэтому вызов неиспользуемого метода падает с паникой
+type fakeUserRepo struct {
+	user.Repository
+	users []entity.User
+}
+
+func (r *fakeUserRepo) List(context.Context) ([]entity.User, error) { return r.users, nil }
+
+type fakeTeams struct {
+	service.Team
+	imported []entity.Team
+	dryRun   bool
+}
+
+func (f *fakeTeams) Import(_ context.Context, teams []entity.Team, dryRun bool) (entity.TeamImportPlan, error) {
+	f.imported, f.dryRun = teams, dryRun
+	return entity.TeamImportPlan{Applied: !dryRun}, nil
+}
+
+type fakeUsers struct {
+	service.User
+	deactivated []string
+}
+
+func (f *fakeUsers) SetIsActive(_ context.Context, userID string, isActive bool) (entity.User, error) {
+	f.deactivated = append(f.deactivated, userID)
+	return entity.User{UserId: userID, IsActive: isActive}, nil
+}
+
+type fakePRs struct {
+	service.PullRequest
+	reviews    map[string][]entity.PullRequest
+	reassigned []string
+}
+
+func (f *fakePRs) GetPRsByReviewer(_ context.Context, userID string) (string, []entity.PullRequest, error) {
+	return userID, f.reviews[userID], nil
+}
+
+func (f *fakePRs) ReassignReviewer(_ context.Context, prID, oldReviewerID string) (*entity.PullRequest, string, error) {
+	if prID == "pr-3" {
+		return nil, "", pullrequest.ErrReassignViolation
+	}
+	f.reassigned = append(f.reassigned, prID)
+	return &entity.PullRequest{PullRequestId: prID}, "z", nil
+}
+
+type fixture struct {
+	teams *fakeTeams
+	users *fakeUsers
+	prs   *fakePRs
+	svc   *directory.Service
+}
+
+func newFixture(cfg directory.Config) fixture {
+	source := fakeSource{dir: entity.Directory{
+		Users: []entity.DirectoryUser{
+			{ID: "1", UserName: "a", DisplayName: "Alice", Active: true},
+			{ID: "2", UserName: "b", DisplayName: "Bob", Active: true},
+			{ID: "5", UserName: "e", DisplayName: "Eve", Active: false},
+			{ID: "6", UserName: "f", Active: true},
+		},
+		Groups: []entity.DirectoryGroup{
+			{ID: "g1", DisplayName: "backend", MemberIDs: []string{"1", "2"}},
+			{ID: "g2", DisplayName: "frontend", MemberIDs: []string{"2", "404"}},
+		},
+	}}
+	repo := &fakeUserRepo{users: []entity.User{
+		{UserId: "a", Username: "Alice", TeamName: "backend", IsActive: true},
+		{UserId: "c", Username: "Carl", TeamName: "backend", IsActive: true},
+		{UserId: "d", Username: "Dan", TeamName: "backend", IsActive: false},
+		{UserId: "e", Username: "Eve", TeamName: "backend", IsActive: true},
+	}}
+	f := fixture{
+		teams: &fakeTeams{},
+		users: &fakeUsers{},
+		prs: &fakePRs{reviews: map[string][]entity.PullRequest{
+			"c": {
+				{PullRequestId: "pr-1", Status: entity.PullRequestStatusOPEN},
+				{PullRequestId: "pr-2", Status: entity.PullRequestStatusMERGED},
+			},
+			"e": {{PullRequestId: "pr-3", Status: entity.PullRequestStatusOPEN}},
+		}},
+	}
+	f.svc = directory.NewService(source, repo, f.teams, f.users, f.prs, memdb.New(), cfg)
+	return f
+}
+
+func TestSync_Reconciles(t *testing.T) {
+	f := newFixture(directory.Config{MaxDeactivations: 2})
+
+	report, err := f.svc.Sync(context.Background(), false, false)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	wantTeams := []entity.Team{
+		{TeamName: "backend", Members: []entity.TeamMember{{UserId: "a", Username: "Alice", IsActive: true}}},
+		{TeamName: "frontend", Members: []entity.TeamMember{}},
+	}
+	if !reflect.DeepEqual(f.teams.imported, wantTeams) || f.teams.dryRun {
+		t.Fatalf("unexpected import: %+v dryRun=%v", f.teams.imported, f.teams.dryRun)
+	}
+	if !reflect.DeepEqual(f.users.deactivated, []string{"c", "e"}) {
+		t.Fatalf("expected c and e to be deactivated, got %v", f.users.deactivated)
+	}
+	if !reflect.DeepEqual(report.Reassigned, []entity.DirectoryReassignment{{PullRequestID: "pr-1", OldReviewerID: "c", NewReviewerID: "z"}}) {
+		t.Fatalf("unexpected reassignments: %+v", report.Reassigned)
+	}
+
+	wantUnresolved := []string{
+		"user b belongs to several groups: [backend frontend]",
+		"user f does not belong to any group",
+		"no replacement for e on PR pr-3",
+	}
+	if !reflect.DeepEqual(report.Unresolved, wantUnresolved) {
+		t.Fatalf("unexpected unresolved: %q", report.Unresolved)
+	}
+	if !report.Applied || report.InSync() {
+		t.Fatalf("expected applied report with drift, got %+v", report)
+	}
+}
+
+func TestSync_DryRunChangesNothing(t *testing.T) {
+	f := newFixture(directory.Config{})
+
+	report, err := f.svc.Sync(context.Background(), true, false)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+
+	if !f.teams.dryRun || len(f.users.deactivated) != 0 || len(f.prs.reassigned) != 0 {
+		t.Fatalf("dry run must not apply changes: deactivated=%v reassigned=%v", f.users.deactivated, f.prs.reassigned)
+	}
+	if !reflect.DeepEqual(report.Deactivated, []string{"c", "e"}) {
+		t.Fatalf("expected c and e in report, got %v", report.Deactivated)
+	}
+	wantReassigned := []entity.DirectoryReassignment{
+		{PullRequestID: "pr-1", OldReviewerID: "c"},
+		{PullRequestID: "pr-3", OldReviewerID: "e"},
+	}
+	if !reflect.DeepEqual(report.Reassigned, wantReassigned) || report.Applied {
+		t.Fatalf("unexpected dry run report: %+v", report)
+	}
+}
+
+func TestSync_Disabled(t *testing.T) {
+	svc := directory.NewService(nil, &fakeUserRepo{}, &fakeTeams{}, &fakeUsers{}, &fakePRs{}, memdb.New(), directory.Config{})
+	if _, err := svc.Sync(context.Background(), true, false); !errors.Is(err, directory.ErrSyncDisabled) {
+		t.Fatalf("expected ErrSyncDisabled, got %v", err)
+	}
+}
+
+func TestSync_RefusesMassDeactivation(t *testing.T) {
+	f := newFixture(directory.Config{MaxDeactivations: 1})
+
+	report, err := f.svc.Sync(context.Background(), false, false)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if report.Applied || !f.teams.dryRun || len(f.users.deactivated) != 0 || len(f.prs.reassigned) != 0 {
+		t.Fatalf("sync over the limit must not be applied: %+v", report)
+	}
+	if !reflect.DeepEqual(report.Deactivated, []string{"c", "e"}) {
+		t.Fatalf("expected c and e to be reported, got %v", report.Deactivated)
+	}
+	want := "sync would deactivate 2 users, more than the limit of 1; sync is not applied, force it if this is expected"
+	if !slices.Contains(report.Unresolved, want) {
+		t.Fatalf("expected refusal in %q", report.Unresolved)
+	}
+
+	report, err = f.svc.Sync(context.Background(), false, true)
+	if err != nil {
+		t.Fatalf("forced sync: %v", err)
+	}
+	if !report.Applied || !reflect.DeepEqual(f.users.deactivated, []string{"c", "e"}) {
+		t.Fatalf("forced sync must be applied: %+v deactivated=%v", report, f.users.deactivated)
+	}
+}
+
+func TestSync_RefusesEmptyDirectory(t *testing.T) {
+	server := scimtest.NewServer("secret")
+	defer server.Close()
+	server.Set(nil, []scimtest.Group{{ID: "g1", DisplayName: "backend"}})
+
+	repo := &fakeUserRepo{users: []entity.User{
+		{UserId: "a", Username: "Alice", TeamName: "backend", IsActive: true},
+		{UserId: "b", Username: "Bob", TeamName: "backend", IsActive: true},
+	}}
+	teams, users, prs := &fakeTeams{}, &fakeUsers{}, &fakePRs{}
+	client := scim.NewClient(scim.Config{BaseURL: server.URL, Token: "secret", PageSize: 10, Timeout: time.Second})
+	svc := directory.NewService(client, repo, teams, users, prs, memdb.New(), directory.Config{})
+
+	// пустой каталог не применяется даже принудительно
+	report, err := svc.Sync(context.Background(), false, true)
+	if err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if report.Applied || !teams.dryRun || len(users.deactivated) != 0 {
+		t.Fatalf("empty directory must not be applied: %+v deactivated=%v", report, users.deactivated)
+	}
+	if !reflect.DeepEqual(report.Deactivated, []string{"a", "b"}) || report.InSync() {
+		t.Fatalf("expected drift for a and b, got %+v", report)
+	}
+	if !slices.Contains(report.Unresolved, "directory returned no users, sync is not applied") {
+		t.Fatalf("expected refusal in %q", report.Unresolved)
+	}
+}
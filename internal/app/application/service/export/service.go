@@ -0,0 +1,142 @@
+package export
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	// Stream построчно читает набор данных и передаёт значения в fn в порядке
+	// dataset.Columns(). Срез row переиспользуется между вызовами.
+	Stream(ctx context.Context, dataset entity.ExportDataset, fn func(row []any) error) error
+}
+
+var (
+	ErrUnknownDataset = errors.New("unknown export dataset")
+	ErrUnknownFormat  = errors.New("unknown export format")
+)
+
+type Service struct {
+	exportRepo Repository
+}
+
+func NewService(exportRepo Repository) *Service {
+	return &Service{
+		exportRepo: exportRepo,
+	}
+}
+
+// Export пишет полную выгрузку набора в w, не накапливая строки в памяти.
+func (s *Service) Export(ctx context.Context, dataset entity.ExportDataset, format entity.ExportFormat, w io.Writer) error {
+	if !dataset.Valid() {
+		return ErrUnknownDataset
+	}
+
+	var enc encoder
+	switch format {
+	case entity.ExportCSV:
+		enc = newCSVEncoder(w, dataset.Columns())
+	case entity.ExportNDJSON:
+		enc = newNDJSONEncoder(w, dataset.Columns())
+	default:
+		return ErrUnknownFormat
+	}
+
+	if err := enc.Begin(); err != nil {
+		return fmt.Errorf("write %s header: %w", dataset, err)
+	}
+	if err := s.exportRepo.Stream(ctx, dataset, enc.Row); err != nil {
+		return fmt.Errorf("stream %s: %w", dataset, err)
+	}
+	if err := enc.End(); err != nil {
+		return fmt.Errorf("flush %s: %w", dataset, err)
+	}
+
+	return nil
+}
+
+type encoder interface {
+	Begin() error
+	Row(row []any) error
+	End() error
+}
+
+type csvEncoder struct {
+	w       *csv.Writer
+	columns []string
+	record  []string
+}
+
+func newCSVEncoder(w io.Writer, columns []string) *csvEncoder {
+	return &csvEncoder{
+		w:       csv.NewWriter(w),
+		columns: columns,
+		record:  make([]string, len(columns)),
+	}
+}
+
+func (e *csvEncoder) Begin() error { return e.w.Write(e.columns) }
+
+func (e *csvEncoder) Row(row []any) error {
+	for i, v := range row {
+		e.record[i] = csvValue(v)
+	}
+	return e.w.Write(e.record)
+}
+
+func (e *csvEncoder) End() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type ndjsonEncoder struct {
+	enc     *json.Encoder
+	columns []string
+	object  map[string]any
+}
+
+func newNDJSONEncoder(w io.Writer, columns []string) *ndjsonEncoder {
+	return &ndjsonEncoder{
+		enc:     json.NewEncoder(w),
+		columns: columns,
+		object:  make(map[string]any, len(columns)),
+	}
+}
+
+func (e *ndjsonEncoder) Begin() error { return nil }
+
+func (e *ndjsonEncoder) Row(row []any) error {
+	for i, v := range row {
+		if t, ok := v.(time.Time); ok {
+			v = t.UTC()
+		}
+		e.object[e.columns[i]] = v
+	}
+	return e.enc.Encode(e.object)
+}
+
+func (e *ndjsonEncoder) End() error { return nil }
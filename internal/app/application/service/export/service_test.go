@@ -0,0 +1,78 @@
+package export_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/export"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeRepo struct {
+	rows [][]any
+}
+
+func (r *fakeRepo) WithDB(db.DB) export.Repository { return r }
+
+func (r *fakeRepo) BeginTx(context.Context) (db.Tx, error) { return nil, errors.New("not supported") }
+
+func (r *fakeRepo) Stream(_ context.Context, _ entity.ExportDataset, fn func(row []any) error) error {
+	for _, row := range r.rows {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var created = time.Date(2025, 11, 20, 10, 0, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+func newRepo() *fakeRepo {
+	return &fakeRepo{rows: [][]any{
+		{"pr-1", "Add search", "u1", "MERGED", created, created.Add(time.Hour)},
+		{"pr-2", "Fix, \"quoted\"", "u2", "OPEN", created, nil},
+	}}
+}
+
+func TestExport_CSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := export.NewService(newRepo()).Export(context.Background(), entity.ExportPullRequests, entity.ExportCSV, &buf)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	want := "pull_request_id,pull_request_name,author_id,status,created_at,merged_at\n" +
+		"pr-1,Add search,u1,MERGED,2025-11-20T07:00:00Z,2025-11-20T08:00:00Z\n" +
+		"pr-2,\"Fix, \"\"quoted\"\"\",u2,OPEN,2025-11-20T07:00:00Z,\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected csv:\n%s", buf.String())
+	}
+}
+
+func TestExport_NDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := export.NewService(newRepo()).Export(context.Background(), entity.ExportPullRequests, entity.ExportNDJSON, &buf)
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+
+	want := `{"author_id":"u1","created_at":"2025-11-20T07:00:00Z","merged_at":"2025-11-20T08:00:00Z","pull_request_id":"pr-1","pull_request_name":"Add search","status":"MERGED"}` + "\n" +
+		`{"author_id":"u2","created_at":"2025-11-20T07:00:00Z","merged_at":null,"pull_request_id":"pr-2","pull_request_name":"Fix, \"quoted\"","status":"OPEN"}` + "\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected ndjson:\n%s", buf.String())
+	}
+}
+
+func TestExport_Validation(t *testing.T) {
+	s := export.NewService(newRepo())
+
+	if err := s.Export(context.Background(), "secrets", entity.ExportCSV, &bytes.Buffer{}); !errors.Is(err, export.ErrUnknownDataset) {
+		t.Fatalf("expected ErrUnknownDataset, got %v", err)
+	}
+	if err := s.Export(context.Background(), entity.ExportUsers, "xml", &bytes.Buffer{}); !errors.Is(err, export.ErrUnknownFormat) {
+		t.Fatalf("expected ErrUnknownFormat, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package idempotency
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	// Reserve сохраняет незавершённую запись, если ключ свободен, прежняя
+	// запись истекла или зависла в выполнении дольше staleBefore. Возвращает
+	// false, если ключ занят.
+	Reserve(ctx context.Context, rec entity.IdempotencyRecord, staleBefore time.Time) (bool, error)
+	Get(ctx context.Context, route string, key string) (*entity.IdempotencyRecord, error)
+	// Complete и Delete ничего не делают, если ключ занят с другим token.
+	Complete(ctx context.Context, route string, key string, token string, statusCode int, contentType string, body []byte) error
+	Delete(ctx context.Context, route string, key string, token string) error
+	DeleteExpired(ctx context.Context, now time.Time) error
+}
+
+type Config struct {
+	// TTL — сколько хранится ответ и действует ключ.
+	TTL time.Duration
+	// LockTimeout — через сколько незавершённый запрос (например, оборванный
+	// перезапуском) перестаёт блокировать ключ.
+	LockTimeout time.Duration
+}
+
+var (
+	ErrKeyReused  = errors.New("idempotency key was used with a different request")
+	ErrInProgress = errors.New("request with this idempotency key is still in progress")
+)
+
+type Service struct {
+	repo Repository
+	cfg  Config
+}
+
+func NewService(repo Repository, cfg Config) *Service {
+	return &Service{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// Begin занимает ключ под запрос. Если запрос с этим ключом уже выполнен,
+// возвращает сохранённый ответ. Иначе возвращает token резервирования: запрос
+// нужно выполнить и затем вызвать с ним Complete или Release.
+func (s *Service) Begin(ctx context.Context, route string, key string, fingerprint string) (*entity.IdempotencyRecord, string, error) {
+	// второй проход нужен, если запись истекла и была удалена между Reserve и Get
+	for range 2 {
+		now := time.Now().UTC()
+		token := uuid.NewString()
+		reserved, err := s.repo.Reserve(ctx, entity.IdempotencyRecord{
+			Route:       route,
+			Key:         key,
+			Fingerprint: fingerprint,
+			Token:       token,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(s.cfg.TTL),
+		}, now.Add(-s.cfg.LockTimeout))
+		if err != nil {
+			return nil, "", fmt.Errorf("reserve idempotency key: %w", err)
+		}
+		if reserved {
+			return nil, token, nil
+		}
+
+		existing, err := s.repo.Get(ctx, route, key)
+		if err != nil {
+			return nil, "", fmt.Errorf("get idempotency key: %w", err)
+		}
+		if existing == nil {
+			continue
+		}
+		if existing.Fingerprint != fingerprint {
+			return nil, "", ErrKeyReused
+		}
+		if !existing.Completed() {
+			return nil, "", ErrInProgress
+		}
+		return existing, "", nil
+	}
+
+	return nil, "", ErrInProgress
+}
+
+// Complete сохраняет ответ на запрос, занявший ключ. Если за время выполнения
+// резервирование посчитали зависшим и ключ занял повтор, ответ не сохраняется.
+func (s *Service) Complete(ctx context.Context, route string, key string, token string, statusCode int, contentType string, body []byte) error {
+	if err := s.repo.Complete(ctx, route, key, token, statusCode, contentType, body); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release освобождает ключ, чтобы повтор запроса выполнился заново.
+func (s *Service) Release(ctx context.Context, route string, key string, token string) error {
+	if err := s.repo.Delete(ctx, route, key, token); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Cleanup удаляет истёкшие ключи. Задача планировщика.
+func (s *Service) Cleanup(ctx context.Context, tx db.Tx) error {
+	if err := s.repo.WithDB(tx).DeleteExpired(ctx, time.Now().UTC()); err != nil {
+		return fmt.Errorf("delete expired idempotency keys: %w", err)
+	}
+	return nil
+}
@@ -5,21 +5,69 @@ import (
 	"avito-backend-intern-assignment/internal/app/domain/entity"
 	"avito-backend-intern-assignment/pkg/db"
 	"context"
+	"io"
+	"time"
 )
 
 type Team interface {
-	CreateTeam(ctx context.Context, team entity.Team) error
+	CreateTeam(ctx context.Context, team entity.Team) (entity.Team, error)
 	GetTeamWithMembers(ctx context.Context, teamName string) (entity.Team, error)
+	SetReviewSLA(ctx context.Context, teamName string, sla time.Duration) error
+	Import(ctx context.Context, teams []entity.Team, dryRun bool) (entity.TeamImportPlan, error)
 }
 
 type User interface {
 	UpdateReposDB(db db.TransactionalDB) User
+	Get(ctx context.Context, userID string) (entity.User, error)
 	SetIsActive(ctx context.Context, userID string, isActive bool) (entity.User, error)
 }
 
 type PullRequest interface {
 	Create(ctx context.Context, pr entity.PullRequest) (*entity.PullRequest, error)
+	Get(ctx context.Context, prID string) (*entity.PullRequest, error)
 	MarkMerged(ctx context.Context, prID string) (*entity.PullRequest, error)
+	Close(ctx context.Context, prID string) (*entity.PullRequest, error)
+	Reopen(ctx context.Context, prID string) (*entity.PullRequest, error)
 	ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (*entity.PullRequest, string, error)
+	SubmitReview(ctx context.Context, prID string, reviewerID string, decision entity.ReviewDecision) (*entity.PullRequest, error)
 	GetPRsByReviewer(ctx context.Context, userID string) (string, []entity.PullRequest, error)
 }
+
+type Audit interface {
+	List(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error)
+}
+
+type Webhook interface {
+	Subscribe(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, teamName string) ([]entity.WebhookSubscription, error)
+	Unsubscribe(ctx context.Context, id int64) error
+	ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error)
+}
+
+type VCS interface {
+	HandlePullRequestEvent(ctx context.Context, e entity.VCSPullRequestEvent) (entity.VCSSyncResult, error)
+	LinkIdentity(ctx context.Context, identity entity.VCSIdentity) (entity.VCSIdentity, error)
+	ListIdentities(ctx context.Context, userID string) ([]entity.VCSIdentity, error)
+}
+
+type Notification interface {
+	GetTeamSettings(ctx context.Context, teamName string) (entity.TeamNotificationSettings, error)
+	SetTeamSettings(ctx context.Context, settings entity.TeamNotificationSettings) (entity.TeamNotificationSettings, error)
+	GetUserPreferences(ctx context.Context, userID string) (entity.UserNotificationPreferences, error)
+	SetUserPreferences(ctx context.Context, prefs entity.UserNotificationPreferences) (entity.UserNotificationPreferences, error)
+}
+
+type Stats interface {
+	ReviewerStats(ctx context.Context, window entity.StatsWindow, teamName string) (entity.StatsWindow, []entity.ReviewerStats, error)
+	TeamStats(ctx context.Context, window entity.StatsWindow) (entity.StatsWindow, []entity.TeamStats, error)
+	Fairness(ctx context.Context, teamName string, window entity.StatsWindow, tolerance *float64) (entity.FairnessReport, error)
+	CycleTime(ctx context.Context, window entity.StatsWindow, group entity.CycleTimeGroup) (entity.StatsWindow, []entity.CycleTimeBucket, error)
+}
+
+type Export interface {
+	Export(ctx context.Context, dataset entity.ExportDataset, format entity.ExportFormat, w io.Writer) error
+}
+
+type Directory interface {
+	Sync(ctx context.Context, dryRun, force bool) (entity.DirectorySyncReport, error)
+}
@@ -0,0 +1,131 @@
+package notification
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Digest собирает ежедневную сводку: пользователю — открытые PR, ожидающие
+// его ревью, и накопленные уведомления; каналу команды в режиме DIGEST —
+// накопленные уведомления.
+type Digest struct {
+	notificationRepo Repository
+	prRepo           pullrequest.Repository
+	notifiers        map[entity.NotificationChannel]Notifier
+}
+
+func NewDigest(notificationRepo Repository, prRepo pullrequest.Repository, notifiers ...Notifier) *Digest {
+	byChannel := make(map[entity.NotificationChannel]Notifier, len(notifiers))
+	for _, n := range notifiers {
+		byChannel[n.Channel()] = n
+	}
+
+	return &Digest{
+		notificationRepo: notificationRepo,
+		prRepo:           prRepo,
+		notifiers:        byChannel,
+	}
+}
+
+// Send отправляет сводки. Ошибка отправки одному получателю только
+// логируется: транзакция с отметками об уже отправленных сводках не должна
+// откатываться. Неотправленные уведомления попадут в следующую сводку.
+func (d *Digest) Send(ctx context.Context, tx db.Tx) error {
+	txNotificationRepo := d.notificationRepo.WithDB(tx)
+	txPRRepo := d.prRepo.WithDB(tx)
+
+	if email := d.notifiers[entity.NotificationChannelEmail]; email != nil {
+		recipients, err := txNotificationRepo.ListDigestRecipients(ctx)
+		if err != nil {
+			return fmt.Errorf("list digest recipients: %w", err)
+		}
+
+		for _, prefs := range recipients {
+			prs, err := txPRRepo.GetByAssignedReviewer(ctx, prefs.UserID)
+			if err != nil {
+				return fmt.Errorf("get reviews of %s: %w", prefs.UserID, err)
+			}
+			pending := make([]entity.PullRequest, 0, len(prs))
+			for _, pr := range prs {
+				if pr.Status == entity.PullRequestStatusOPEN {
+					pending = append(pending, pr)
+				}
+			}
+
+			if err := d.send(ctx, txNotificationRepo, email, prefs.Email, pending); err != nil {
+				log.Printf("ERROR: Failed to send digest to %s: %v", prefs.UserID, err)
+			}
+		}
+	}
+
+	if chat := d.notifiers[entity.NotificationChannelChat]; chat != nil {
+		urls, err := txNotificationRepo.ListPendingDigestRecipients(ctx, entity.NotificationChannelChat)
+		if err != nil {
+			return fmt.Errorf("list chat digest recipients: %w", err)
+		}
+
+		for _, url := range urls {
+			if err := d.send(ctx, txNotificationRepo, chat, url, nil); err != nil {
+				log.Printf("ERROR: Failed to send chat digest: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (d *Digest) send(ctx context.Context, repo Repository, notifier Notifier, recipient string, pending []entity.PullRequest) error {
+	queued, err := repo.ListPendingDigest(ctx, notifier.Channel(), recipient)
+	if err != nil {
+		return fmt.Errorf("list queued notifications: %w", err)
+	}
+	if len(pending) == 0 && len(queued) == 0 {
+		return nil
+	}
+
+	var body strings.Builder
+	if len(pending) > 0 {
+		body.WriteString("Pull requests waiting for your review:\n")
+		for _, pr := range pending {
+			fmt.Fprintf(&body, "- %s %s", pr.PullRequestId, pr.PullRequestName)
+			if pr.CreatedAt != nil {
+				fmt.Fprintf(&body, " (open since %s)", pr.CreatedAt.Format(time.DateOnly))
+			}
+			body.WriteString("\n")
+		}
+	}
+	if len(queued) > 0 {
+		if len(pending) > 0 {
+			body.WriteString("\n")
+		}
+		body.WriteString("Updates:\n")
+		for _, n := range queued {
+			fmt.Fprintf(&body, "- %s\n", n.Body)
+		}
+	}
+
+	err = notifier.Send(ctx, entity.Notification{
+		Channel:   notifier.Channel(),
+		Recipient: recipient,
+		Mode:      entity.NotificationModeDigest,
+		Subject:   fmt.Sprintf("Review digest for %s", time.Now().UTC().Format(time.DateOnly)),
+		Body:      body.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+
+	now := time.Now().UTC()
+	for _, n := range queued {
+		if err := repo.MarkSent(ctx, n.ID, now); err != nil {
+			log.Printf("ERROR: Notification %d was sent in digest but not marked: %v", n.ID, err)
+		}
+	}
+	return nil
+}
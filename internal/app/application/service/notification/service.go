@@ -0,0 +1,161 @@
+package notification
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"time"
+)
+
+// DefaultMode применяется, пока команда или пользователь не задали настройки.
+const DefaultMode = entity.NotificationModeInstant
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	GetTeamSettings(ctx context.Context, teamName string) (*entity.TeamNotificationSettings, error)
+	UpsertTeamSettings(ctx context.Context, settings entity.TeamNotificationSettings) error
+	GetUserPreferences(ctx context.Context, userID string) (*entity.UserNotificationPreferences, error)
+	UpsertUserPreferences(ctx context.Context, prefs entity.UserNotificationPreferences) error
+
+	// Enqueue сохраняет уведомление и возвращает его с ID. Для уже существующей
+	// тройки (outbox_message_id, channel, recipient) возвращается сохранённая
+	// запись, что делает повторную обработку сообщения outbox безопасной.
+	Enqueue(ctx context.Context, n entity.Notification) (entity.Notification, error)
+	MarkSent(ctx context.Context, id int64, sentAt time.Time) error
+
+	// ListDigestRecipients возвращает активных пользователей с email, которые не
+	// отключили уведомления ни сами, ни на уровне команды.
+	ListDigestRecipients(ctx context.Context) ([]entity.UserNotificationPreferences, error)
+	// ListPendingDigestRecipients возвращает получателей канала, для которых
+	// накопились неотправленные уведомления в режиме DIGEST.
+	ListPendingDigestRecipients(ctx context.Context, channel entity.NotificationChannel) ([]string, error)
+	ListPendingDigest(ctx context.Context, channel entity.NotificationChannel, recipient string) ([]entity.Notification, error)
+}
+
+// Notifier отправляет уведомление через внешний канал. n.Recipient задан в
+// терминах канала: URL входящего вебхука для чата, адрес для email.
+type Notifier interface {
+	Channel() entity.NotificationChannel
+	Send(ctx context.Context, n entity.Notification) error
+}
+
+var (
+	ErrInvalidMode  = errors.New("notification mode must be INSTANT, DIGEST or OFF")
+	ErrInvalidURL   = errors.New("chat webhook url must be an absolute http(s) url")
+	ErrInvalidEmail = errors.New("invalid email address")
+)
+
+type Service struct {
+	notificationRepo Repository
+	teamRepo         team.Repository
+	userRepo         user.Repository
+}
+
+func NewService(notificationRepo Repository, teamRepo team.Repository, userRepo user.Repository) *Service {
+	return &Service{
+		notificationRepo: notificationRepo,
+		teamRepo:         teamRepo,
+		userRepo:         userRepo,
+	}
+}
+
+func (s *Service) GetTeamSettings(ctx context.Context, teamName string) (entity.TeamNotificationSettings, error) {
+	exists, err := s.teamRepo.Exists(ctx, teamName)
+	if err != nil {
+		return entity.TeamNotificationSettings{}, fmt.Errorf("check team exists: %w", err)
+	}
+	if !exists {
+		return entity.TeamNotificationSettings{}, team.ErrTeamNotFound
+	}
+
+	settings, err := s.notificationRepo.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		return entity.TeamNotificationSettings{}, fmt.Errorf("get team settings: %w", err)
+	}
+	if settings == nil {
+		return entity.TeamNotificationSettings{TeamName: teamName, Mode: DefaultMode}, nil
+	}
+
+	return *settings, nil
+}
+
+func (s *Service) SetTeamSettings(ctx context.Context, settings entity.TeamNotificationSettings) (entity.TeamNotificationSettings, error) {
+	if !settings.Mode.Valid() {
+		return entity.TeamNotificationSettings{}, ErrInvalidMode
+	}
+	if settings.ChatWebhookURL != "" {
+		parsed, err := url.Parse(settings.ChatWebhookURL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return entity.TeamNotificationSettings{}, ErrInvalidURL
+		}
+	}
+
+	exists, err := s.teamRepo.Exists(ctx, settings.TeamName)
+	if err != nil {
+		return entity.TeamNotificationSettings{}, fmt.Errorf("check team exists: %w", err)
+	}
+	if !exists {
+		return entity.TeamNotificationSettings{}, team.ErrTeamNotFound
+	}
+
+	settings.UpdatedAt = time.Now().UTC()
+	if err := s.notificationRepo.UpsertTeamSettings(ctx, settings); err != nil {
+		return entity.TeamNotificationSettings{}, fmt.Errorf("upsert team settings: %w", err)
+	}
+
+	return settings, nil
+}
+
+func (s *Service) GetUserPreferences(ctx context.Context, userID string) (entity.UserNotificationPreferences, error) {
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return entity.UserNotificationPreferences{}, fmt.Errorf("get user: %w", err)
+	}
+	if u == nil {
+		return entity.UserNotificationPreferences{}, user.ErrUserNotFound
+	}
+
+	prefs, err := s.notificationRepo.GetUserPreferences(ctx, userID)
+	if err != nil {
+		return entity.UserNotificationPreferences{}, fmt.Errorf("get user preferences: %w", err)
+	}
+	if prefs == nil {
+		return entity.UserNotificationPreferences{UserID: userID, Mode: DefaultMode}, nil
+	}
+
+	return *prefs, nil
+}
+
+func (s *Service) SetUserPreferences(ctx context.Context, prefs entity.UserNotificationPreferences) (entity.UserNotificationPreferences, error) {
+	if !prefs.Mode.Valid() {
+		return entity.UserNotificationPreferences{}, ErrInvalidMode
+	}
+	if prefs.Email != "" {
+		addr, err := mail.ParseAddress(prefs.Email)
+		if err != nil || addr.Name != "" {
+			return entity.UserNotificationPreferences{}, ErrInvalidEmail
+		}
+		prefs.Email = addr.Address
+	}
+
+	u, err := s.userRepo.GetByID(ctx, prefs.UserID)
+	if err != nil {
+		return entity.UserNotificationPreferences{}, fmt.Errorf("get user: %w", err)
+	}
+	if u == nil {
+		return entity.UserNotificationPreferences{}, user.ErrUserNotFound
+	}
+
+	prefs.UpdatedAt = time.Now().UTC()
+	if err := s.notificationRepo.UpsertUserPreferences(ctx, prefs); err != nil {
+		return entity.UserNotificationPreferences{}, fmt.Errorf("upsert user preferences: %w", err)
+	}
+
+	return prefs, nil
+}
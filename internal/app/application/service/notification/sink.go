@@ -0,0 +1,147 @@
+package notification
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+)
+
+// Sink превращает события outbox в уведомления о назначении и замене
+// ревьюверов, о merge PR и напоминания о ревью. Канал команды получает сообщение, если для неё
+// задан чат; участники — письмо, если указали email. Режим DIGEST только
+// сохраняет уведомление для ежедневной сводки.
+type Sink struct {
+	notificationRepo Repository
+	notifiers        map[entity.NotificationChannel]Notifier
+}
+
+func NewSink(notificationRepo Repository, notifiers ...Notifier) *Sink {
+	byChannel := make(map[entity.NotificationChannel]Notifier, len(notifiers))
+	for _, n := range notifiers {
+		byChannel[n.Channel()] = n
+	}
+
+	return &Sink{
+		notificationRepo: notificationRepo,
+		notifiers:        byChannel,
+	}
+}
+
+func (s *Sink) Name() string { return "notification" }
+
+func (s *Sink) Deliver(ctx context.Context, msg entity.OutboxMessage) error {
+	e, err := event.Decode(msg.EventType, msg.Payload)
+	if err != nil {
+		return err
+	}
+
+	subject, body, recipients, ok := describe(e)
+	if !ok {
+		return nil
+	}
+
+	teamMode := DefaultMode
+	var chatURL string
+	settings, err := s.notificationRepo.GetTeamSettings(ctx, e.Team())
+	if err != nil {
+		return fmt.Errorf("get team settings: %w", err)
+	}
+	if settings != nil {
+		teamMode = settings.Mode
+		chatURL = settings.ChatWebhookURL
+	}
+	if teamMode == entity.NotificationModeOff {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	var targets []entity.Notification
+	if chatURL != "" && s.notifiers[entity.NotificationChannelChat] != nil {
+		targets = append(targets, entity.Notification{
+			Channel:   entity.NotificationChannelChat,
+			Recipient: chatURL,
+			Mode:      teamMode,
+		})
+	}
+
+	if s.notifiers[entity.NotificationChannelEmail] != nil {
+		for _, userID := range recipients {
+			prefs, err := s.notificationRepo.GetUserPreferences(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("get user %s preferences: %w", userID, err)
+			}
+			if prefs == nil || prefs.Email == "" || prefs.Mode == entity.NotificationModeOff {
+				continue
+			}
+			targets = append(targets, entity.Notification{
+				Channel:   entity.NotificationChannelEmail,
+				Recipient: prefs.Email,
+				Mode:      prefs.Mode,
+			})
+		}
+	}
+
+	var errs []error
+	for _, target := range targets {
+		target.OutboxMessageID = msg.ID
+		target.Subject = subject
+		target.Body = body
+		target.CreatedAt = now
+
+		if err := s.notify(ctx, target); err != nil {
+			errs = append(errs, fmt.Errorf("%s notification: %w", target.Channel, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Sink) notify(ctx context.Context, target entity.Notification) error {
+	n, err := s.notificationRepo.Enqueue(ctx, target)
+	if err != nil {
+		return fmt.Errorf("enqueue: %w", err)
+	}
+	if n.Mode != entity.NotificationModeInstant || n.SentAt != nil {
+		return nil
+	}
+
+	if err := s.notifiers[n.Channel].Send(ctx, n); err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+
+	if err := s.notificationRepo.MarkSent(ctx, n.ID, time.Now().UTC()); err != nil {
+		log.Printf("ERROR: Notification %d was sent but not marked: %v", n.ID, err)
+	}
+	return nil
+}
+
+// describe возвращает текст уведомления и пользователей, которым оно адресовано.
+// ok == false для событий, о которых не уведомляем.
+func describe(e event.Event) (subject, body string, recipients []string, ok bool) {
+	switch e := e.(type) {
+	case event.ReviewerAssigned:
+		subject = fmt.Sprintf("Review requested: %s", e.PullRequestID)
+		body = fmt.Sprintf("%s was assigned to review pull request %s.", e.ReviewerID, e.PullRequestID)
+	case event.ReviewerReplaced:
+		subject = fmt.Sprintf("Reviewer replaced: %s", e.PullRequestID)
+		body = fmt.Sprintf("%s replaced %s as a reviewer of pull request %s.", e.NewReviewerID, e.OldReviewerID, e.PullRequestID)
+	case event.ReviewReminder:
+		subject = fmt.Sprintf("Review reminder: %s", e.PullRequestID)
+		body = fmt.Sprintf("Pull request %s has been waiting for review by %s since %s.",
+			e.PullRequestID, e.ReviewerID, e.WaitingSince.Format(time.RFC1123))
+	case event.PullRequestMerged:
+		subject = fmt.Sprintf("Pull request merged: %s", e.PullRequestID)
+		body = fmt.Sprintf("Pull request %s by %s was merged.", e.PullRequestID, e.AuthorID)
+	default:
+		return "", "", nil, false
+	}
+
+	recipients = slices.Clone(e.Users())
+	slices.Sort(recipients)
+	return subject, body, slices.Compact(recipients), true
+}
@@ -0,0 +1,131 @@
+package outbox
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Sink — получатель событий из outbox. Доставка выполняется по семантике
+// at-least-once: при ошибке любого из получателей сообщение будет отправлено
+// повторно всем получателям, поэтому Deliver должен быть идемпотентным
+// относительно msg.ID.
+type Sink interface {
+	Name() string
+	Deliver(ctx context.Context, msg entity.OutboxMessage) error
+}
+
+type DispatcherConfig struct {
+	PollInterval time.Duration
+	BatchSize    uint64
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+}
+
+type Dispatcher struct {
+	outboxRepo Repository
+	txProvider db.Transactional
+	sinks      []Sink
+	cfg        DispatcherConfig
+}
+
+func NewDispatcher(outboxRepo Repository, txProvider db.Transactional, cfg DispatcherConfig, sinks ...Sink) *Dispatcher {
+	return &Dispatcher{
+		outboxRepo: outboxRepo,
+		txProvider: txProvider,
+		sinks:      sinks,
+		cfg:        cfg,
+	}
+}
+
+// Run обрабатывает outbox до отмены ctx.
+func (d *Dispatcher) Run(ctx context.Context) {
+	log.Printf("Outbox dispatcher started: sinks=%d, poll=%s", len(d.sinks), d.cfg.PollInterval)
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			processed, err := d.DispatchBatch(ctx)
+			if err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("ERROR: Outbox dispatch failed: %v", err)
+			}
+			// полная пачка — вероятно, в очереди есть ещё сообщения
+			if err != nil || uint64(processed) < d.cfg.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Println("Outbox dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchBatch доставляет одну пачку сообщений и возвращает их количество.
+func (d *Dispatcher) DispatchBatch(ctx context.Context) (int, error) {
+	var processed int
+
+	err := db.WithTx(ctx, d.txProvider, func(ctx context.Context, tx db.Tx) error {
+		txRepo := d.outboxRepo.WithDB(tx)
+
+		messages, err := txRepo.FetchPending(ctx, time.Now().UTC(), d.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("fetch pending messages: %w", err)
+		}
+
+		// получатели пишут в свои таблицы и ходят во внешние системы вне
+		// транзакции пачки: их ошибка не должна откатывать отметки о уже
+		// доставленных сообщениях
+		sinkCtx := db.WithoutTx(ctx)
+		for _, msg := range messages {
+			if err := d.deliver(sinkCtx, msg); err != nil {
+				attempts := msg.Attempts + 1
+				nextAttemptAt := time.Now().UTC().Add(d.backoff(attempts))
+				log.Printf("ERROR: Failed to deliver outbox message %d (%s), attempt %d, next at %s: %v",
+					msg.ID, msg.EventType, attempts, nextAttemptAt.Format(time.RFC3339), err)
+
+				if err := txRepo.MarkFailed(ctx, msg.ID, attempts, nextAttemptAt, err.Error()); err != nil {
+					return fmt.Errorf("mark message %d failed: %w", msg.ID, err)
+				}
+			} else if err := txRepo.MarkDelivered(ctx, msg.ID, time.Now().UTC()); err != nil {
+				return fmt.Errorf("mark message %d delivered: %w", msg.ID, err)
+			}
+
+			processed++
+		}
+
+		return nil
+	})
+
+	return processed, err
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, msg entity.OutboxMessage) error {
+	var errs []error
+	for _, sink := range d.sinks {
+		if err := sink.Deliver(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	backoff := d.cfg.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+	return backoff
+}
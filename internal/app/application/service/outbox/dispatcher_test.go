@@ -0,0 +1,69 @@
+package outbox_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/memory"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// recordingSink падает на сообщениях из fail и запоминает, видел ли он
+// транзакцию диспетчера.
+type recordingSink struct {
+	fail      map[string]bool
+	delivered []string
+	sawTx     bool
+}
+
+func (s *recordingSink) Name() string { return "recording" }
+
+func (s *recordingSink) Deliver(ctx context.Context, msg entity.OutboxMessage) error {
+	if _, ok := db.TxFromContext(ctx); ok {
+		s.sawTx = true
+	}
+	if s.fail[msg.AggregateID] {
+		return errors.New("insert notification: connection reset")
+	}
+	s.delivered = append(s.delivered, msg.AggregateID)
+	return nil
+}
+
+func TestDispatcher_FailingSinkKeepsOtherMessagesCommitted(t *testing.T) {
+	store := memdb.New()
+	repo := memory.NewOutboxRepository(store)
+	ctx := context.Background()
+
+	now := time.Now().UTC()
+	err := repo.Add(ctx,
+		entity.OutboxMessage{EventType: "pull_request.created", AggregateID: "pr-1", CreatedAt: now, NextAttemptAt: now},
+		entity.OutboxMessage{EventType: "pull_request.created", AggregateID: "pr-2", CreatedAt: now, NextAttemptAt: now},
+		entity.OutboxMessage{EventType: "pull_request.created", AggregateID: "pr-3", CreatedAt: now, NextAttemptAt: now},
+	)
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+
+	sink := &recordingSink{fail: map[string]bool{"pr-2": true}}
+	d := outbox.NewDispatcher(repo, store, outbox.DispatcherConfig{BatchSize: 10, BaseBackoff: time.Second, MaxBackoff: time.Minute}, sink)
+
+	processed, err := d.DispatchBatch(ctx)
+	if err != nil || processed != 3 {
+		t.Fatalf("expected 3 processed messages, got %d (%v)", processed, err)
+	}
+	if sink.sawTx {
+		t.Fatal("sink must not run inside the dispatcher transaction")
+	}
+
+	pending, err := repo.FetchPending(ctx, now.Add(time.Hour), 10)
+	if err != nil {
+		t.Fatalf("fetch pending: %v", err)
+	}
+	if len(pending) != 1 || pending[0].AggregateID != "pr-2" || pending[0].Attempts != 1 || pending[0].LastError == "" {
+		t.Fatalf("expected only the failed message to stay pending with one attempt, got %+v", pending)
+	}
+}
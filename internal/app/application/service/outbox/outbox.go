@@ -0,0 +1,54 @@
+package outbox
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	Add(ctx context.Context, messages ...entity.OutboxMessage) error
+	// FetchPending блокирует (FOR UPDATE SKIP LOCKED) и возвращает недоставленные
+	// сообщения, время очередной попытки которых уже наступило. Вызывать в транзакции.
+	FetchPending(ctx context.Context, now time.Time, limit uint64) ([]entity.OutboxMessage, error)
+	MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error
+	MarkFailed(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error
+}
+
+// Publish записывает события в outbox. Вызывать его нужно с контекстом
+// транзакции мутации (внутри db.WithTx) — тогда события появятся тогда и
+// только тогда, когда зафиксируется само изменение.
+func Publish(ctx context.Context, repo Repository, events ...event.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	messages := make([]entity.OutboxMessage, 0, len(events))
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshal %s event: %w", e.EventType(), err)
+		}
+
+		messages = append(messages, entity.OutboxMessage{
+			EventType:     string(e.EventType()),
+			AggregateID:   e.AggregateID(),
+			TeamName:      e.Team(),
+			Payload:       payload,
+			CreatedAt:     now,
+			NextAttemptAt: now,
+		})
+	}
+
+	if err := repo.Add(ctx, messages...); err != nil {
+		return fmt.Errorf("add outbox messages: %w", err)
+	}
+
+	return nil
+}
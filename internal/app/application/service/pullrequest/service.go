@@ -1,8 +1,13 @@
 package pullrequest
 
 import (
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
 	"avito-backend-intern-assignment/internal/app/application/service/user"
 	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
 	"avito-backend-intern-assignment/pkg/db"
 	"context"
 	"errors"
@@ -17,11 +22,39 @@ type Repository interface {
 	db.TransactionalRepository[Repository]
 	Create(ctx context.Context, pr entity.PullRequest) error
 	GetByID(ctx context.Context, id string) (*entity.PullRequest, error)
+	// LockVersion блокирует PR до конца транзакции и возвращает его версию;
+	// db.ErrNotFound, если PR нет. Все изменяющие методы увеличивают версию.
+	LockVersion(ctx context.Context, prID string) (int64, error)
 	UpdateStatus(ctx context.Context, prID string, status entity.PRStatus, mergedAt *time.Time) error
-	ReplaceReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) error
+	ReplaceReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, assignedAt time.Time) error
+	// GetByAssignedReviewer заполняет Reviews только назначением userID.
 	GetByAssignedReviewer(ctx context.Context, userID string) ([]entity.PullRequest, error)
+	SetDecision(ctx context.Context, prID string, reviewerID string, decision entity.ReviewDecision, decidedAt time.Time) error
+	// ListOverdue возвращает назначения открытых PR без решения, у которых
+	// истёк SLA команды автора (или defaultSLA, если он не задан). Сначала
+	// идут назначения без попыток эскалации, затем — с самой давней попыткой.
+	ListOverdue(ctx context.Context, now time.Time, defaultSLA time.Duration, limit uint64) ([]entity.OverdueReview, error)
+	// MarkEscalationAttempted запоминает неудачную попытку эскалации, чтобы
+	// следующий запуск начал с других назначений. Версию PR не меняет.
+	MarkEscalationAttempted(ctx context.Context, prID string, reviewerID string, at time.Time) error
 }
 
+type Config struct {
+	// DefaultReviewSLA применяется к командам без собственного SLA.
+	DefaultReviewSLA    time.Duration
+	EscalationBatchSize uint64
+}
+
+// AssignmentTxOptions — выбор ревьюверов читает состав команды и нагрузку, а
+// пишет назначения: без сериализуемой изоляции параллельные запросы видят
+// одни и те же данные и принимают несогласованные решения.
+//
+// Create, ReassignReviewer и эскалация открывают точку сохранения с этими
+// опциями. Вызывающий, который объединяет их со своими изменениями в одной
+// транзакции, должен открыть её с AssignmentTxOptions, иначе вызов вернёт
+// db.ErrTxOptionsConflict.
+var AssignmentTxOptions = db.TxOptions{Isolation: db.Serializable}
+
 var (
 	ErrPullRequestExists   = errors.New("pull request already exists")
 	ErrPullRequestNotFound = errors.New("pull request not found")
@@ -29,24 +62,47 @@ var (
 	ErrTeamNotFound        = errors.New("team not found")
 	ErrReassignViolation   = errors.New("reassigning reviewer violates domain rules")
 	ErrNotEnoughReviewers  = errors.New("not enough active reviewers in the team")
+	ErrPullRequestMerged   = errors.New("pull request is already merged")
+	ErrPullRequestNotOpen  = errors.New("pull request is not open")
+	ErrReviewerNotAssigned = errors.New("reviewer is not assigned to pull request")
+	ErrInvalidDecision     = errors.New("invalid review decision")
 )
 
 type Service struct {
 	prRepo     Repository
 	userRepo   user.Repository
+	teamRepo   team.Repository
+	auditRepo  audit.Repository
+	outboxRepo outbox.Repository
 	txProvider db.Transactional
+	publisher  event.Publisher
+	cfg        Config
 }
 
-func NewService(prRepo Repository, userRepo user.Repository, txProvider db.Transactional) *Service {
+func NewService(
+	prRepo Repository,
+	userRepo user.Repository,
+	teamRepo team.Repository,
+	auditRepo audit.Repository,
+	outboxRepo outbox.Repository,
+	txProvider db.Transactional,
+	publisher event.Publisher,
+	cfg Config,
+) *Service {
 	return &Service{
 		prRepo:     prRepo,
 		userRepo:   userRepo,
+		teamRepo:   teamRepo,
+		auditRepo:  auditRepo,
+		outboxRepo: outboxRepo,
 		txProvider: txProvider,
+		publisher:  publisher,
+		cfg:        cfg,
 	}
 }
 
-func (s *Service) getRandomTeamReviewers(ctx context.Context, userRepo user.Repository, teamName string, n int, excludedUserIDs ...string) ([]string, error) {
-	teamMembers, err := userRepo.GetByTeam(ctx, teamName)
+func (s *Service) getRandomTeamReviewers(ctx context.Context, teamName string, n int, excludedUserIDs ...string) ([]string, error) {
+	teamMembers, err := s.userRepo.GetByTeam(ctx, teamName)
 	if err != nil {
 		log.Printf("ERROR: Failed to get team members for team '%s': %v", teamName, err)
 		return nil, fmt.Errorf("get team members: %w", err)
@@ -96,76 +152,275 @@ func (s *Service) selectRandomReviewers(reviewers []string, n int) []string {
 }
 
 func (s *Service) Create(ctx context.Context, pr entity.PullRequest) (*entity.PullRequest, error) {
-	existing, err := s.prRepo.GetByID(ctx, pr.PullRequestId)
-	if err != nil {
-		log.Printf("ERROR: Failed to check if PR exists (ID: %s): %v", pr.PullRequestId, err)
-		return nil, fmt.Errorf("check pr exists: %w", err)
-	}
-	if existing != nil {
-		log.Printf("ERROR: PR already exists (ID: %s)", pr.PullRequestId)
-		return nil, ErrPullRequestExists
-	}
+	var events []event.Event
 
-	author, err := s.userRepo.GetByID(ctx, pr.AuthorId)
-	if err != nil {
-		log.Printf("ERROR: Failed to get author (ID: %s): %v", pr.AuthorId, err)
-		return nil, fmt.Errorf("get author: %w", err)
-	}
-	if author == nil {
-		log.Printf("ERROR: Author not found (ID: %s)", pr.AuthorId)
-		return nil, ErrAuthorNotFound
-	}
+	err := db.WithTxRetry(ctx, s.txProvider, AssignmentTxOptions, db.DefaultRetryPolicy, func(ctx context.Context, _ db.Tx) error {
+		existing, err := s.prRepo.GetByID(ctx, pr.PullRequestId)
+		if err != nil {
+			log.Printf("ERROR: Failed to check if PR exists (ID: %s): %v", pr.PullRequestId, err)
+			return fmt.Errorf("check pr exists: %w", err)
+		}
+		if existing != nil {
+			log.Printf("ERROR: PR already exists (ID: %s)", pr.PullRequestId)
+			return ErrPullRequestExists
+		}
 
-	selectedReviewers, err := s.getRandomTeamReviewers(ctx, s.userRepo, author.TeamName, 2, pr.AuthorId)
-	if err != nil {
-		log.Printf("ERROR: Failed to select reviewers for PR %s: %v", pr.PullRequestId, err)
-		return nil, fmt.Errorf("select reviewers: %w", err)
-	}
+		author, err := s.userRepo.GetByID(ctx, pr.AuthorId)
+		if err != nil {
+			log.Printf("ERROR: Failed to get author (ID: %s): %v", pr.AuthorId, err)
+			return fmt.Errorf("get author: %w", err)
+		}
+		if author == nil {
+			log.Printf("ERROR: Author not found (ID: %s)", pr.AuthorId)
+			return ErrAuthorNotFound
+		}
+
+		selectedReviewers, err := s.getRandomTeamReviewers(ctx, author.TeamName, 2, pr.AuthorId)
+		if err != nil {
+			log.Printf("ERROR: Failed to select reviewers for PR %s: %v", pr.PullRequestId, err)
+			return fmt.Errorf("select reviewers: %w", err)
+		}
+
+		pr.AssignedReviewers = selectedReviewers
+		pr.Status = entity.PullRequestStatusOPEN
+		createdAt := time.Now().UTC()
+		pr.CreatedAt = &createdAt
+		pr.Reviews = make([]entity.ReviewAssignment, 0, len(selectedReviewers))
+		for _, reviewerID := range selectedReviewers {
+			pr.Reviews = append(pr.Reviews, entity.ReviewAssignment{ReviewerID: reviewerID, AssignedAt: createdAt})
+		}
 
-	pr.AssignedReviewers = selectedReviewers
-	pr.Status = entity.PullRequestStatusOPEN
-	createdAt := time.Now().UTC()
-	pr.CreatedAt = &createdAt
+		if err := s.prRepo.Create(ctx, pr); err != nil {
+			// параллельный запрос мог вставить тот же PR после проверки выше
+			if db.IsConstraint(err, db.ErrConflict, "pullrequests_pkey") {
+				log.Printf("ERROR: PR already exists (ID: %s)", pr.PullRequestId)
+				return ErrPullRequestExists
+			}
+			// автора могли удалить после проверки выше
+			if db.IsConstraint(err, db.ErrForeignKey, "pullrequests_author_id_fkey") {
+				log.Printf("ERROR: Author not found (ID: %s)", pr.AuthorId)
+				return ErrAuthorNotFound
+			}
+			log.Printf("ERROR: Failed to create PR in database (ID: %s): %v", pr.PullRequestId, err)
+			return fmt.Errorf("create pr in database: %w", err)
+		}
+		pr.Version = 1
+
+		err = audit.Record(ctx, s.auditRepo, entity.AuditActionPullRequestCreated, entity.AuditEntityPullRequest, pr.PullRequestId,
+			nil, pr)
+		if err != nil {
+			return fmt.Errorf("audit pr %s: %w", pr.PullRequestId, err)
+		}
+
+		events = make([]event.Event, 0, len(pr.AssignedReviewers)+1)
+		events = append(events, event.PullRequestCreated{
+			PullRequestID:     pr.PullRequestId,
+			PullRequestName:   pr.PullRequestName,
+			AuthorID:          pr.AuthorId,
+			TeamName:          author.TeamName,
+			AssignedReviewers: pr.AssignedReviewers,
+			CreatedAt:         createdAt,
+		})
+		for _, reviewerID := range pr.AssignedReviewers {
+			events = append(events, event.ReviewerAssigned{
+				PullRequestID: pr.PullRequestId,
+				ReviewerID:    reviewerID,
+				TeamName:      author.TeamName,
+				AssignedAt:    createdAt,
+			})
+		}
+		if err := outbox.Publish(ctx, s.outboxRepo, events...); err != nil {
+			return fmt.Errorf("publish pr %s events: %w", pr.PullRequestId, err)
+		}
 
-	if err := s.prRepo.Create(ctx, pr); err != nil {
-		log.Printf("ERROR: Failed to create PR in database (ID: %s): %v", pr.PullRequestId, err)
-		return nil, fmt.Errorf("create pr in database: %w", err)
+		return s.evaluateSLA(ctx, &pr, author.TeamName)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
 	return &pr, nil
 }
 
 func (s *Service) MarkMerged(ctx context.Context, prID string) (*entity.PullRequest, error) {
 	log.Printf("Marking PR as merged: ID=%s", prID)
 
-	pr, err := s.prRepo.GetByID(ctx, prID)
+	var mergedPR *entity.PullRequest
+	var events []event.Event
+
+	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, _ db.Tx) error {
+		if err := s.lockVersion(ctx, prID); err != nil {
+			return err
+		}
+		pr, err := s.prRepo.GetByID(ctx, prID)
+		if err != nil {
+			log.Printf("ERROR: Failed to get PR for merging (ID: %s): %v", prID, err)
+			return fmt.Errorf("get pr: %w", err)
+		}
+		if pr == nil {
+			log.Printf("ERROR: PR not found for merging (ID: %s)", prID)
+			return ErrPullRequestNotFound
+		}
+
+		if pr.Status == entity.PullRequestStatusMERGED {
+			log.Printf("PR %s is already merged", prID)
+			mergedPR = pr
+			return nil
+		}
+
+		before := *pr
+		now := time.Now().UTC()
+		log.Printf("Updating PR %s status to MERGED at %v", prID, now)
+
+		if err := s.prRepo.UpdateStatus(ctx, prID, entity.PullRequestStatusMERGED, &now); err != nil {
+			log.Printf("ERROR: Failed to update PR status (ID: %s): %v", prID, err)
+			return fmt.Errorf("update pr status: %w", err)
+		}
+
+		pr.Status = entity.PullRequestStatusMERGED
+		pr.MergedAt = &now
+		pr.Version++
+
+		err = audit.Record(ctx, s.auditRepo, entity.AuditActionPullRequestMerged, entity.AuditEntityPullRequest, prID,
+			before, *pr)
+		if err != nil {
+			return fmt.Errorf("audit pr %s: %w", prID, err)
+		}
+
+		author, err := s.userRepo.GetByID(ctx, pr.AuthorId)
+		if err != nil {
+			return fmt.Errorf("get author: %w", err)
+		}
+		var teamName string
+		if author != nil {
+			teamName = author.TeamName
+		}
+
+		events = append(events, event.PullRequestMerged{
+			PullRequestID:     pr.PullRequestId,
+			AuthorID:          pr.AuthorId,
+			TeamName:          teamName,
+			AssignedReviewers: pr.AssignedReviewers,
+			MergedAt:          now,
+		})
+		if err := outbox.Publish(ctx, s.outboxRepo, events...); err != nil {
+			return fmt.Errorf("publish pr %s merge: %w", prID, err)
+		}
+
+		mergedPR = pr
+		return nil
+	})
 	if err != nil {
-		log.Printf("ERROR: Failed to get PR for merging (ID: %s): %v", prID, err)
-		return nil, fmt.Errorf("get pr: %w", err)
-	}
-	if pr == nil {
-		log.Printf("ERROR: PR not found for merging (ID: %s)", prID)
-		return nil, ErrPullRequestNotFound
+		return nil, err
 	}
 
-	if pr.Status == entity.PullRequestStatusMERGED {
-		log.Printf("PR %s is already merged", prID)
-		return pr, nil
-	}
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	log.Printf("Successfully marked PR %s as merged", prID)
+	return mergedPR, nil
+}
 
-	now := time.Now().UTC()
-	log.Printf("Updating PR %s status to MERGED at %v", prID, now)
+// Close закрывает PR без слияния. Повторное закрытие ничего не меняет.
+func (s *Service) Close(ctx context.Context, prID string) (*entity.PullRequest, error) {
+	log.Printf("Closing PR: ID=%s", prID)
 
-	if err := s.prRepo.UpdateStatus(ctx, prID, entity.PullRequestStatusMERGED, &now); err != nil {
-		log.Printf("ERROR: Failed to update PR status (ID: %s): %v", prID, err)
-		return nil, fmt.Errorf("update pr status: %w", err)
-	}
+	return s.transitionStatus(ctx, prID, entity.PullRequestStatusCLOSED, func(pr entity.PullRequest, teamName string, at time.Time) (entity.AuditAction, event.Event) {
+		return entity.AuditActionPullRequestClosed, event.PullRequestClosed{
+			PullRequestID: pr.PullRequestId,
+			AuthorID:      pr.AuthorId,
+			TeamName:      teamName,
+			ClosedAt:      at,
+		}
+	})
+}
 
-	pr.Status = entity.PullRequestStatusMERGED
-	pr.MergedAt = &now
+// Reopen возвращает закрытый PR в состояние OPEN с прежними ревьюверами.
+func (s *Service) Reopen(ctx context.Context, prID string) (*entity.PullRequest, error) {
+	log.Printf("Reopening PR: ID=%s", prID)
+
+	return s.transitionStatus(ctx, prID, entity.PullRequestStatusOPEN, func(pr entity.PullRequest, teamName string, at time.Time) (entity.AuditAction, event.Event) {
+		return entity.AuditActionPullRequestReopened, event.PullRequestReopened{
+			PullRequestID:     pr.PullRequestId,
+			AuthorID:          pr.AuthorId,
+			TeamName:          teamName,
+			AssignedReviewers: pr.AssignedReviewers,
+			ReopenedAt:        at,
+		}
+	})
+}
 
-	log.Printf("Successfully marked PR %s as merged", prID)
-	return pr, nil
+// transitionStatus переводит не слитый PR между OPEN и CLOSED, фиксируя аудит
+// и доменное событие, которые строит describe.
+func (s *Service) transitionStatus(
+	ctx context.Context,
+	prID string,
+	status entity.PRStatus,
+	describe func(pr entity.PullRequest, teamName string, at time.Time) (entity.AuditAction, event.Event),
+) (*entity.PullRequest, error) {
+	var updatedPR *entity.PullRequest
+	var events []event.Event
+
+	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, _ db.Tx) error {
+		if err := s.lockVersion(ctx, prID); err != nil {
+			return err
+		}
+		pr, err := s.prRepo.GetByID(ctx, prID)
+		if err != nil {
+			log.Printf("ERROR: Failed to get PR (ID: %s): %v", prID, err)
+			return fmt.Errorf("get pr: %w", err)
+		}
+		if pr == nil {
+			return ErrPullRequestNotFound
+		}
+		if pr.Status == entity.PullRequestStatusMERGED {
+			return ErrPullRequestMerged
+		}
+		if pr.Status == status {
+			updatedPR = pr
+			return nil
+		}
+
+		before := *pr
+		if err := s.prRepo.UpdateStatus(ctx, prID, status, nil); err != nil {
+			log.Printf("ERROR: Failed to update PR status (ID: %s): %v", prID, err)
+			return fmt.Errorf("update pr status: %w", err)
+		}
+		pr.Status = status
+		pr.Version++
+
+		author, err := s.userRepo.GetByID(ctx, pr.AuthorId)
+		if err != nil {
+			return fmt.Errorf("get author: %w", err)
+		}
+		var teamName string
+		if author != nil {
+			teamName = author.TeamName
+		}
+
+		action, e := describe(*pr, teamName, time.Now().UTC())
+		err = audit.Record(ctx, s.auditRepo, action, entity.AuditEntityPullRequest, prID, before, *pr)
+		if err != nil {
+			return fmt.Errorf("audit pr %s: %w", prID, err)
+		}
+		if err := outbox.Publish(ctx, s.outboxRepo, e); err != nil {
+			return fmt.Errorf("publish pr %s %s: %w", prID, e.EventType(), err)
+		}
+		events = append(events, e)
+
+		if err := s.evaluateSLA(ctx, pr, teamName); err != nil {
+			return err
+		}
+
+		updatedPR = pr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	log.Printf("PR %s is now %s", prID, updatedPR.Status)
+	return updatedPR, nil
 }
 
 func (s *Service) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (*entity.PullRequest, string, error) {
@@ -173,12 +428,15 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID string, oldReviewer
 
 	var updatedPR *entity.PullRequest
 	var newReviewerID string
+	var events []event.Event
 
-	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, tx db.Tx) error {
-		txRepo := s.prRepo.WithDB(tx)
-		txUserRepo := s.userRepo.WithDB(tx)
-
-		pr, err := txRepo.GetByID(ctx, prID)
+	err := db.WithTxRetry(ctx, s.txProvider, AssignmentTxOptions, db.DefaultRetryPolicy, func(ctx context.Context, _ db.Tx) error {
+		// без блокировки параллельные переназначения читают один и тот же
+		// состав ревьюверов и перетирают результаты друг друга
+		if err := s.lockVersion(ctx, prID); err != nil {
+			return err
+		}
+		pr, err := s.prRepo.GetByID(ctx, prID)
 		if err != nil {
 			log.Printf("ERROR: Failed to get PR for reassignment (ID: %s): %v", prID, err)
 			return fmt.Errorf("get pr: %w", err)
@@ -189,65 +447,365 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID string, oldReviewer
 		}
 		log.Printf("PR found: ID=%s, Author=%s, Reviewers=%v", pr.PullRequestId, pr.AuthorId, pr.AssignedReviewers)
 
-		found := slices.Contains(pr.AssignedReviewers, oldReviewerID)
-		if !found {
-			log.Printf("ERROR: Old reviewer %s is not assigned to PR %s. Current reviewers: %v",
-				oldReviewerID, prID, pr.AssignedReviewers)
-			return ErrReassignViolation
+		replaced, err := s.reassign(ctx, pr, oldReviewerID, entity.AuditActionPullRequestReassigned)
+		if err != nil {
+			return err
 		}
 
-		oldUser, err := txUserRepo.GetByID(ctx, oldReviewerID)
-		if err != nil {
-			log.Printf("ERROR: Failed to get old reviewer (ID: %s): %v", oldReviewerID, err)
-			return fmt.Errorf("get old reviewer: %w", err)
+		events = []event.Event{replaced}
+		if err := outbox.Publish(ctx, s.outboxRepo, events...); err != nil {
+			return fmt.Errorf("publish pr %s reassignment: %w", prID, err)
+		}
+
+		if err := s.evaluateSLA(ctx, pr, replaced.TeamName); err != nil {
+			return err
+		}
+
+		newReviewerID = replaced.NewReviewerID
+		updatedPR = pr
+		log.Printf("Successfully reassigned reviewer: PR=%s, Old=%s, New=%s", prID, oldReviewerID, newReviewerID)
+		return nil
+	})
+	if err != nil {
+		log.Printf("ERROR: Transaction failed for reassignment (PR: %s): %v", prID, err)
+	} else {
+		db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	}
+
+	return updatedPR, newReviewerID, err
+}
+
+// reassign заменяет ревьювера oldReviewerID случайным активным участником его
+// команды и фиксирует аудит с действием action. pr обновляется на месте,
+// событие о замене возвращается вызывающему для публикации.
+func (s *Service) reassign(
+	ctx context.Context,
+	pr *entity.PullRequest,
+	oldReviewerID string,
+	action entity.AuditAction,
+) (event.ReviewerReplaced, error) {
+	prID := pr.PullRequestId
+
+	if pr.Status != entity.PullRequestStatusOPEN {
+		log.Printf("ERROR: Cannot reassign reviewer on %s PR %s", pr.Status, prID)
+		return event.ReviewerReplaced{}, ErrReassignViolation
+	}
+
+	found := slices.Contains(pr.AssignedReviewers, oldReviewerID)
+	if !found {
+		log.Printf("ERROR: Old reviewer %s is not assigned to PR %s. Current reviewers: %v",
+			oldReviewerID, prID, pr.AssignedReviewers)
+		return event.ReviewerReplaced{}, ErrReassignViolation
+	}
+
+	oldUser, err := s.userRepo.GetByID(ctx, oldReviewerID)
+	if err != nil {
+		log.Printf("ERROR: Failed to get old reviewer (ID: %s): %v", oldReviewerID, err)
+		return event.ReviewerReplaced{}, fmt.Errorf("get old reviewer: %w", err)
+	}
+	if oldUser == nil {
+		log.Printf("ERROR: Old reviewer not found (ID: %s)", oldReviewerID)
+		return event.ReviewerReplaced{}, user.ErrUserNotFound
+	}
+
+	excludedUsers := make([]string, 0, len(pr.AssignedReviewers)+1)
+	excludedUsers = append(excludedUsers, pr.AssignedReviewers...)
+	excludedUsers = append(excludedUsers, pr.AuthorId)
+
+	log.Printf("Excluding users for replacement: %v", excludedUsers)
+
+	candidateReviewers, err := s.getRandomTeamReviewers(ctx, oldUser.TeamName, 1, excludedUsers...)
+	if err != nil {
+		log.Printf("ERROR: Failed to get replacement reviewer for PR %s: %v", prID, err)
+		return event.ReviewerReplaced{}, fmt.Errorf("get replacement reviewer: %w", err)
+	}
+
+	if len(candidateReviewers) == 0 {
+		log.Printf("ERROR: No candidate reviewers found for reassignment in team %s", oldUser.TeamName)
+		return event.ReviewerReplaced{}, ErrReassignViolation
+	}
+
+	newReviewerID := candidateReviewers[0]
+	log.Printf("Selected new reviewer: %s", newReviewerID)
+
+	now := time.Now().UTC()
+	if err := s.prRepo.ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID, now); err != nil {
+		log.Printf("ERROR: Failed to replace reviewer in database (PR: %s, Old: %s, New: %s): %v",
+			prID, oldReviewerID, newReviewerID, err)
+		return event.ReviewerReplaced{}, fmt.Errorf("replace reviewer: %w", err)
+	}
+
+	before := *pr
+	before.AssignedReviewers = slices.Clone(pr.AssignedReviewers)
+	before.Reviews = slices.Clone(pr.Reviews)
+	pr.Version++
+	for i, r := range pr.AssignedReviewers {
+		if r == oldReviewerID {
+			pr.AssignedReviewers[i] = newReviewerID
+			break
 		}
-		if oldUser == nil {
-			log.Printf("ERROR: Old reviewer not found (ID: %s)", oldReviewerID)
-			return user.ErrUserNotFound
+	}
+	for i, r := range pr.Reviews {
+		if r.ReviewerID == oldReviewerID {
+			pr.Reviews[i] = entity.ReviewAssignment{ReviewerID: newReviewerID, AssignedAt: now}
+			break
 		}
+	}
+
+	err = audit.Record(ctx, s.auditRepo, action, entity.AuditEntityPullRequest, prID, before, *pr)
+	if err != nil {
+		return event.ReviewerReplaced{}, fmt.Errorf("audit pr %s: %w", prID, err)
+	}
+
+	return event.ReviewerReplaced{
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+		TeamName:      oldUser.TeamName,
+		ReplacedAt:    now,
+	}, nil
+}
 
-		excludedUsers := make([]string, 0, len(pr.AssignedReviewers)+1)
-		excludedUsers = append(excludedUsers, pr.AssignedReviewers...)
-		excludedUsers = append(excludedUsers, pr.AuthorId)
+// SubmitReview фиксирует решение назначенного ревьювера, после чего его
+// назначение больше не учитывается в SLA.
+func (s *Service) SubmitReview(ctx context.Context, prID string, reviewerID string, decision entity.ReviewDecision) (*entity.PullRequest, error) {
+	if !decision.Valid() {
+		return nil, ErrInvalidDecision
+	}
 
-		log.Printf("Excluding users for replacement: %v", excludedUsers)
+	var updatedPR *entity.PullRequest
+	var events []event.Event
 
-		candidateReviewers, err := s.getRandomTeamReviewers(ctx, txUserRepo, oldUser.TeamName, 1, excludedUsers...)
+	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, _ db.Tx) error {
+		if err := s.lockVersion(ctx, prID); err != nil {
+			return err
+		}
+		pr, err := s.prRepo.GetByID(ctx, prID)
 		if err != nil {
-			log.Printf("ERROR: Failed to get replacement reviewer for PR %s: %v", prID, err)
-			return fmt.Errorf("get replacement reviewer: %w", err)
+			log.Printf("ERROR: Failed to get PR for review (ID: %s): %v", prID, err)
+			return fmt.Errorf("get pr: %w", err)
+		}
+		if pr == nil {
+			return ErrPullRequestNotFound
+		}
+		if pr.Status == entity.PullRequestStatusMERGED {
+			return ErrPullRequestMerged
+		}
+		if pr.Status != entity.PullRequestStatusOPEN {
+			return ErrPullRequestNotOpen
 		}
 
-		if len(candidateReviewers) == 0 {
-			log.Printf("ERROR: No candidate reviewers found for reassignment in team %s", oldUser.TeamName)
-			return ErrReassignViolation
+		idx := slices.IndexFunc(pr.Reviews, func(r entity.ReviewAssignment) bool {
+			return r.ReviewerID == reviewerID
+		})
+		if idx < 0 {
+			return ErrReviewerNotAssigned
 		}
 
-		newReviewerID = candidateReviewers[0]
-		log.Printf("Selected new reviewer: %s", newReviewerID)
+		before := *pr
+		before.Reviews = slices.Clone(pr.Reviews)
 
-		if err := txRepo.ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID); err != nil {
-			log.Printf("ERROR: Failed to replace reviewer in database (PR: %s, Old: %s, New: %s): %v",
-				prID, oldReviewerID, newReviewerID, err)
-			return fmt.Errorf("replace reviewer: %w", err)
+		now := time.Now().UTC()
+		if err := s.prRepo.SetDecision(ctx, prID, reviewerID, decision, now); err != nil {
+			return fmt.Errorf("set review decision: %w", err)
 		}
+		pr.Reviews[idx].Decision = decision
+		pr.Reviews[idx].DecidedAt = &now
+		pr.Version++
 
-		for i, r := range pr.AssignedReviewers {
-			if r == oldReviewerID {
-				pr.AssignedReviewers[i] = newReviewerID
-				break
-			}
+		err = audit.Record(ctx, s.auditRepo, entity.AuditActionPullRequestReviewed, entity.AuditEntityPullRequest, prID,
+			before, *pr)
+		if err != nil {
+			return fmt.Errorf("audit pr %s: %w", prID, err)
+		}
+
+		author, err := s.userRepo.GetByID(ctx, pr.AuthorId)
+		if err != nil {
+			return fmt.Errorf("get author: %w", err)
+		}
+		var teamName string
+		if author != nil {
+			teamName = author.TeamName
+		}
+
+		events = append(events, event.ReviewSubmitted{
+			PullRequestID: prID,
+			ReviewerID:    reviewerID,
+			Decision:      string(decision),
+			TeamName:      teamName,
+			DecidedAt:     now,
+		})
+		if err := outbox.Publish(ctx, s.outboxRepo, events...); err != nil {
+			return fmt.Errorf("publish pr %s review: %w", prID, err)
+		}
+
+		if err := s.evaluateSLA(ctx, pr, teamName); err != nil {
+			return err
 		}
 
 		updatedPR = pr
-		log.Printf("Successfully reassigned reviewer: PR=%s, Old=%s, New=%s", prID, oldReviewerID, newReviewerID)
 		return nil
 	})
 	if err != nil {
-		log.Printf("ERROR: Transaction failed for reassignment (PR: %s): %v", prID, err)
+		return nil, err
 	}
 
-	return updatedPR, newReviewerID, err
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	log.Printf("Reviewer %s submitted %s on PR %s", reviewerID, decision, prID)
+	return updatedPR, nil
+}
+
+// EscalateOverdue переназначает ревьюверов, не вынесших решение за SLA
+// команды. Каждая эскалация выполняется в собственной транзакции, чтобы
+// невозможность найти замену в одной команде не блокировала остальные.
+func (s *Service) EscalateOverdue(ctx context.Context, tx db.Tx) error {
+	overdue, err := s.prRepo.WithDB(tx).ListOverdue(ctx, time.Now().UTC(), s.cfg.DefaultReviewSLA, s.cfg.EscalationBatchSize)
+	if err != nil {
+		return fmt.Errorf("list overdue reviews: %w", err)
+	}
+
+	for _, o := range overdue {
+		err := s.escalate(ctx, o)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrNotEnoughReviewers), errors.Is(err, ErrReassignViolation):
+			log.Printf("Scheduler: no replacement for overdue reviewer %s on PR %s: %v", o.ReviewerID, o.PullRequestID, err)
+			// без отметки такие назначения занимали бы весь пакет каждого запуска;
+			// пишем её вне транзакции задачи, как и саму эскалацию
+			if err := s.prRepo.MarkEscalationAttempted(ctx, o.PullRequestID, o.ReviewerID, time.Now().UTC()); err != nil {
+				return fmt.Errorf("mark escalation of pr %s reviewer %s: %w", o.PullRequestID, o.ReviewerID, err)
+			}
+		default:
+			return fmt.Errorf("escalate pr %s reviewer %s: %w", o.PullRequestID, o.ReviewerID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) escalate(ctx context.Context, overdue entity.OverdueReview) error {
+	var events []event.Event
+
+	err := db.WithTxRetry(ctx, s.txProvider, AssignmentTxOptions, db.DefaultRetryPolicy, func(ctx context.Context, _ db.Tx) error {
+		// события прерванной попытки не должны попасть в шину
+		events = nil
+
+		if _, err := s.prRepo.LockVersion(ctx, overdue.PullRequestID); err != nil {
+			if errors.Is(err, db.ErrNotFound) {
+				return nil
+			}
+			return fmt.Errorf("lock pr: %w", err)
+		}
+		pr, err := s.prRepo.GetByID(ctx, overdue.PullRequestID)
+		if err != nil {
+			return fmt.Errorf("get pr: %w", err)
+		}
+		if pr == nil || pr.Status != entity.PullRequestStatusOPEN {
+			return nil
+		}
+
+		// ревьювер мог успеть вынести решение или быть заменён вручную
+		stillOverdue := slices.ContainsFunc(pr.Reviews, func(r entity.ReviewAssignment) bool {
+			return r.ReviewerID == overdue.ReviewerID && r.Decision == "" && r.AssignedAt.Equal(overdue.AssignedAt)
+		})
+		if !stillOverdue {
+			return nil
+		}
+
+		sla, err := s.reviewSLA(ctx, overdue.TeamName)
+		if err != nil {
+			return err
+		}
+
+		replaced, err := s.reassign(ctx, pr, overdue.ReviewerID, entity.AuditActionPullRequestEscalated)
+		if err != nil {
+			return err
+		}
+
+		events = []event.Event{replaced, event.ReviewEscalated{
+			PullRequestID:    overdue.PullRequestID,
+			LapsedReviewerID: overdue.ReviewerID,
+			NewReviewerID:    replaced.NewReviewerID,
+			TeamName:         overdue.TeamName,
+			AssignedAt:       overdue.AssignedAt,
+			SLASeconds:       int64(sla / time.Second),
+			EscalatedAt:      replaced.ReplacedAt,
+		}}
+		return outbox.Publish(ctx, s.outboxRepo, events...)
+	})
+	if err != nil {
+		return err
+	}
+
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	if len(events) > 0 {
+		log.Printf("Scheduler: escalated PR %s from %s", overdue.PullRequestID, overdue.ReviewerID)
+	}
+	return nil
+}
+
+// lockVersion блокирует PR до конца транзакции и сверяет его версию с
+// If-Match запроса.
+func (s *Service) lockVersion(ctx context.Context, prID string) error {
+	version, err := s.prRepo.LockVersion(ctx, prID)
+	if errors.Is(err, db.ErrNotFound) {
+		return ErrPullRequestNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("lock pr: %w", err)
+	}
+	return service.CheckVersion(ctx, entity.AuditEntityPullRequest, prID, version)
+}
+
+// reviewSLA возвращает SLA команды или значение по умолчанию из конфигурации.
+func (s *Service) reviewSLA(ctx context.Context, teamName string) (time.Duration, error) {
+	sla, err := s.teamRepo.GetReviewSLA(ctx, teamName)
+	if err != nil {
+		return 0, fmt.Errorf("get team %s review sla: %w", teamName, err)
+	}
+	if sla == nil {
+		return s.cfg.DefaultReviewSLA, nil
+	}
+	return *sla, nil
+}
+
+func (s *Service) evaluateSLA(ctx context.Context, pr *entity.PullRequest, teamName string) error {
+	if pr.Status != entity.PullRequestStatusOPEN {
+		pr.SLAStatus = ""
+		return nil
+	}
+
+	sla, err := s.reviewSLA(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	pr.SLAStatus = entity.EvaluateReviewSLA(*pr, sla, time.Now().UTC())
+	return nil
+}
+
+func (s *Service) Get(ctx context.Context, prID string) (*entity.PullRequest, error) {
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("get pr: %w", err)
+	}
+	if pr == nil {
+		return nil, ErrPullRequestNotFound
+	}
+
+	author, err := s.userRepo.GetByID(ctx, pr.AuthorId)
+	if err != nil {
+		return nil, fmt.Errorf("get author: %w", err)
+	}
+	var teamName string
+	if author != nil {
+		teamName = author.TeamName
+	}
+	if err := s.evaluateSLA(ctx, pr, teamName); err != nil {
+		return nil, err
+	}
+
+	return pr, nil
 }
 
 func (s *Service) GetPRsByReviewer(ctx context.Context, userID string) (string, []entity.PullRequest, error) {
@@ -256,7 +814,7 @@ func (s *Service) GetPRsByReviewer(ctx context.Context, userID string) (string,
 	u, err := s.userRepo.GetByID(ctx, userID)
 	if err != nil {
 		log.Printf("ERROR: Failed to get user for PR lookup (ID: %s): %v", userID, err)
-		return "", nil, user.ErrUserNotFound
+		return "", nil, fmt.Errorf("get user: %w", err)
 	}
 	if u == nil {
 		log.Printf("ERROR: User not found for PR lookup (ID: %s)", userID)
@@ -270,6 +828,17 @@ func (s *Service) GetPRsByReviewer(ctx context.Context, userID string) (string,
 		return "", nil, fmt.Errorf("get PRs for reviewer: %w", err)
 	}
 
+	if slices.ContainsFunc(prs, func(pr entity.PullRequest) bool { return pr.Status == entity.PullRequestStatusOPEN }) {
+		sla, err := s.reviewSLA(ctx, u.TeamName)
+		if err != nil {
+			return "", nil, err
+		}
+		now := time.Now().UTC()
+		for i := range prs {
+			prs[i].SLAStatus = entity.EvaluateReviewSLA(prs[i], sla, now)
+		}
+	}
+
 	log.Printf("Found %d PRs for reviewer %s", len(prs), userID)
 	return userID, prs, nil
 }
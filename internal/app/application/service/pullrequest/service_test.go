@@ -0,0 +1,492 @@
+package pullrequest_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/memory"
+	"avito-backend-intern-assignment/internal/pkg/requestctx"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fixture struct {
+	prs    *pullrequest.Service
+	users  *user.Service
+	prRepo *memory.PullRequestRepository
+	audit  *memory.AuditRepository
+	store  *memdb.DB
+}
+
+// newFixture поднимает сервисы на хранилище в памяти с командой backend из
+// автора и трёх активных ревьюверов.
+func newFixture(t *testing.T) fixture {
+	t.Helper()
+	return newFixtureWith(t, nil)
+}
+
+// newFixtureWith позволяет подменить репозиторий PR, которым пользуется сервис.
+func newFixtureWith(t *testing.T, wrap func(pullrequest.Repository) pullrequest.Repository) fixture {
+	t.Helper()
+
+	store := memdb.New()
+	userRepo := memory.NewUserRepository(store)
+	teamRepo := memory.NewTeamRepository(store)
+	auditRepo := memory.NewAuditRepository(store)
+	outboxRepo := memory.NewOutboxRepository(store)
+	bus := eventbus.New(0)
+
+	teams := team.NewService(teamRepo, userRepo, auditRepo, outboxRepo, store, bus)
+	_, err := teams.CreateTeam(context.Background(), entity.Team{TeamName: "backend", Members: []entity.TeamMember{
+		{UserId: "author", Username: "Author", IsActive: true},
+		{UserId: "r1", Username: "R1", IsActive: true},
+		{UserId: "r2", Username: "R2", IsActive: true},
+		{UserId: "r3", Username: "R3", IsActive: true},
+		{UserId: "idle", Username: "Idle", IsActive: false},
+	}})
+	if err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+
+	prRepo := memory.NewPullRequestRepository(store)
+	var serviceRepo pullrequest.Repository = prRepo
+	if wrap != nil {
+		serviceRepo = wrap(prRepo)
+	}
+	prs := pullrequest.NewService(serviceRepo, userRepo, teamRepo, auditRepo, outboxRepo, store, bus,
+		pullrequest.Config{DefaultReviewSLA: time.Hour, EscalationBatchSize: 10})
+	users := user.NewService(userRepo, auditRepo, outboxRepo, store, bus)
+	return fixture{prs: prs, users: users, prRepo: prRepo, audit: auditRepo, store: store}
+}
+
+func (f fixture) auditCount(t *testing.T, action entity.AuditAction) int {
+	t.Helper()
+	events, err := f.audit.List(context.Background(), entity.AuditFilter{Action: action, Limit: 100})
+	if err != nil {
+		t.Fatalf("list audit: %v", err)
+	}
+	return len(events)
+}
+
+func TestService_CreateAssignsActiveTeammates(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	pr, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if len(pr.AssignedReviewers) != 2 {
+		t.Fatalf("expected 2 reviewers, got %v", pr.AssignedReviewers)
+	}
+	for _, reviewer := range pr.AssignedReviewers {
+		if !slices.Contains([]string{"r1", "r2", "r3"}, reviewer) {
+			t.Fatalf("unexpected reviewer %q", reviewer)
+		}
+	}
+	if pr.Status != entity.PullRequestStatusOPEN {
+		t.Fatalf("expected OPEN, got %s", pr.Status)
+	}
+}
+
+func TestService_CreateDuplicateRollsBack(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	if _, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"}); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	_, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Again", AuthorId: "author"})
+	if !errors.Is(err, pullrequest.ErrPullRequestExists) {
+		t.Fatalf("expected ErrPullRequestExists, got %v", err)
+	}
+
+	if got := f.auditCount(t, entity.AuditActionPullRequestCreated); got != 1 {
+		t.Fatalf("expected one pull_request.created audit event, got %d", got)
+	}
+}
+
+func TestService_ReassignAndMerge(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	created, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	old := created.AssignedReviewers[0]
+
+	pr, newReviewer, err := f.prs.ReassignReviewer(ctx, "pr-1", old)
+	if err != nil {
+		t.Fatalf("reassign: %v", err)
+	}
+	if newReviewer == old || slices.Contains(pr.AssignedReviewers, old) || !slices.Contains(pr.AssignedReviewers, newReviewer) {
+		t.Fatalf("reviewer %s was not replaced: new %s, reviewers %v", old, newReviewer, pr.AssignedReviewers)
+	}
+
+	merged, err := f.prs.MarkMerged(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("merge: %v", err)
+	}
+	if merged.Status != entity.PullRequestStatusMERGED || merged.MergedAt == nil {
+		t.Fatalf("expected merged PR, got %+v", merged)
+	}
+
+	if _, _, err := f.prs.ReassignReviewer(ctx, "pr-1", newReviewer); !errors.Is(err, pullrequest.ErrReassignViolation) {
+		t.Fatalf("expected ErrReassignViolation, got %v", err)
+	}
+}
+
+// staleRepository не видит уже созданный PR при проверке существования, как
+// запрос, прочитавший данные до вставки параллельного: дубликат обнаруживается
+// только на вставке.
+type staleRepository struct {
+	pullrequest.Repository
+}
+
+func (r staleRepository) WithDB(d db.DB) pullrequest.Repository {
+	return staleRepository{Repository: r.Repository.WithDB(d)}
+}
+
+func (r staleRepository) GetByID(context.Context, string) (*entity.PullRequest, error) {
+	return nil, nil
+}
+
+func TestService_ConcurrentDuplicateCreates(t *testing.T) {
+	const attempts = 16
+	f := newFixtureWith(t, func(repo pullrequest.Repository) pullrequest.Repository {
+		return staleRepository{Repository: repo}
+	})
+	ctx := context.Background()
+
+	errs := make(chan error, attempts)
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	var created int
+	for err := range errs {
+		switch {
+		case err == nil:
+			created++
+		case !errors.Is(err, pullrequest.ErrPullRequestExists):
+			t.Fatalf("expected ErrPullRequestExists for duplicate, got %v", err)
+		}
+	}
+	if created != 1 {
+		t.Fatalf("expected exactly one successful create, got %d", created)
+	}
+
+	pr, err := f.prRepo.GetByID(ctx, "pr-1")
+	if err != nil || pr == nil {
+		t.Fatalf("get: %+v, %v", pr, err)
+	}
+	if len(pr.AssignedReviewers) != 2 {
+		t.Fatalf("expected 2 reviewers, got %v", pr.AssignedReviewers)
+	}
+	if got := f.auditCount(t, entity.AuditActionPullRequestCreated); got != 1 {
+		t.Fatalf("expected one pull_request.created audit event, got %d", got)
+	}
+}
+
+// flakyRepository прерывает первую замену ревьювера ошибкой сериализации, как
+// Postgres при конфликте параллельных транзакций.
+type flakyRepository struct {
+	pullrequest.Repository
+	failures *atomic.Int32
+}
+
+func (r flakyRepository) WithDB(d db.DB) pullrequest.Repository {
+	return flakyRepository{Repository: r.Repository.WithDB(d), failures: r.failures}
+}
+
+func (r flakyRepository) ReplaceReviewer(ctx context.Context, prID, oldReviewerID, newReviewerID string, assignedAt time.Time) error {
+	if err := r.Repository.ReplaceReviewer(ctx, prID, oldReviewerID, newReviewerID, assignedAt); err != nil {
+		return err
+	}
+	if r.failures.Add(-1) >= 0 {
+		return fmt.Errorf("replace reviewer: %w", db.ErrSerialization)
+	}
+	return nil
+}
+
+func TestService_ReassignRetriesSerializationFailure(t *testing.T) {
+	var failures atomic.Int32
+	failures.Store(1)
+	f := newFixtureWith(t, func(repo pullrequest.Repository) pullrequest.Repository {
+		return flakyRepository{Repository: repo, failures: &failures}
+	})
+	ctx := context.Background()
+
+	created, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, _, err := f.prs.ReassignReviewer(ctx, "pr-1", created.AssignedReviewers[0]); err != nil {
+		t.Fatalf("expected reassignment to succeed after retry, got %v", err)
+	}
+
+	// изменения прерванной попытки откатились вместе с транзакцией
+	stored, err := f.prRepo.GetByID(ctx, "pr-1")
+	if err != nil || stored == nil || stored.Version != 2 {
+		t.Fatalf("expected a single reassignment at version 2, got %+v (%v)", stored, err)
+	}
+	if n := f.auditCount(t, entity.AuditActionPullRequestReassigned); n != 1 {
+		t.Fatalf("expected 1 reassignment audit event, got %d", n)
+	}
+}
+
+func TestService_IfMatchGuardsMutations(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	created, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if created.Version != 1 {
+		t.Fatalf("expected version 1 for new PR, got %d", created.Version)
+	}
+	reviewer := created.AssignedReviewers[0]
+
+	reviewed, err := f.prs.SubmitReview(ifMatch(ctx, "pr-1", 1), "pr-1", reviewer, entity.ReviewDecisionApproved)
+	if err != nil {
+		t.Fatalf("review with current version: %v", err)
+	}
+	if reviewed.Version != 2 {
+		t.Fatalf("expected version 2 after review, got %d", reviewed.Version)
+	}
+
+	// If-Match относится к конкретному PR
+	if _, err := f.prs.MarkMerged(ifMatch(ctx, "pr-other", 1), "pr-1"); err != nil {
+		t.Fatalf("merge with If-Match for another PR: %v", err)
+	}
+	if _, err := f.prs.Reopen(ifMatch(ctx, "pr-1", 2), "pr-1"); !errors.Is(err, service.ErrVersionMismatch) {
+		t.Fatalf("expected ErrVersionMismatch for stale version, got %v", err)
+	}
+
+	stored, err := f.prRepo.GetByID(ctx, "pr-1")
+	if err != nil || stored == nil || stored.Version != 3 || stored.Status != entity.PullRequestStatusMERGED {
+		t.Fatalf("expected merged PR at version 3, got %+v (%v)", stored, err)
+	}
+	if got, err := f.prs.Get(ctx, "pr-1"); err != nil || got.Version != stored.Version {
+		t.Fatalf("expected Get to return version %d, got %+v (%v)", stored.Version, got, err)
+	}
+}
+
+func TestService_ConcurrentReassignsWithIfMatch(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	created, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	// два тимлида одновременно заменяют разных ревьюверов, видя одну версию
+	errs := make([]error, len(created.AssignedReviewers))
+	var wg sync.WaitGroup
+	for i, reviewer := range created.AssignedReviewers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _, errs[i] = f.prs.ReassignReviewer(ifMatch(ctx, "pr-1", created.Version), "pr-1", reviewer)
+		}()
+	}
+	wg.Wait()
+
+	var succeeded int
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			succeeded++
+		case !errors.Is(err, service.ErrVersionMismatch):
+			t.Fatalf("expected ErrVersionMismatch for the loser, got %v", err)
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("expected exactly one reassignment, got %d: %v", succeeded, errs)
+	}
+
+	stored, err := f.prRepo.GetByID(ctx, "pr-1")
+	if err != nil || stored == nil || stored.Version != 2 || len(stored.AssignedReviewers) != 2 {
+		t.Fatalf("expected one reassignment at version 2, got %+v (%v)", stored, err)
+	}
+	if n := f.auditCount(t, entity.AuditActionPullRequestReassigned); n != 1 {
+		t.Fatalf("expected 1 reassignment audit event, got %d", n)
+	}
+}
+
+// Деактивация и переназначение, выполненные в одной внешней транзакции,
+// фиксируются и откатываются вместе.
+func TestService_ComposesInOuterTransaction(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	created, err := f.prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	reviewer := created.AssignedReviewers[0]
+	offboard := func(ctx context.Context, _ db.Tx) error {
+		if _, err := f.users.SetIsActive(ctx, reviewer, false); err != nil {
+			return err
+		}
+		_, _, err := f.prs.ReassignReviewer(ctx, "pr-1", reviewer)
+		return err
+	}
+
+	errBoom := errors.New("boom")
+	err = db.WithTxOptions(ctx, f.store, pullrequest.AssignmentTxOptions, func(ctx context.Context, tx db.Tx) error {
+		if err := offboard(ctx, tx); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected boom, got %v", err)
+	}
+	if u, err := f.users.Get(ctx, reviewer); err != nil || !u.IsActive {
+		t.Fatalf("deactivation must be rolled back: %+v (%v)", u, err)
+	}
+	if pr, _ := f.prs.Get(ctx, "pr-1"); pr == nil || pr.Version != 1 || !slices.Contains(pr.AssignedReviewers, reviewer) {
+		t.Fatalf("reassignment must be rolled back: %+v", pr)
+	}
+
+	// без AssignmentTxOptions внешняя транзакция не даёт нужной изоляции
+	err = db.WithTx(ctx, f.store, offboard)
+	if !errors.Is(err, db.ErrTxOptionsConflict) {
+		t.Fatalf("expected ErrTxOptionsConflict, got %v", err)
+	}
+
+	if err := db.WithTxOptions(ctx, f.store, pullrequest.AssignmentTxOptions, offboard); err != nil {
+		t.Fatalf("offboard: %v", err)
+	}
+	if u, err := f.users.Get(ctx, reviewer); err != nil || u.IsActive {
+		t.Fatalf("expected inactive reviewer, got %+v (%v)", u, err)
+	}
+	if pr, _ := f.prs.Get(ctx, "pr-1"); pr == nil || slices.Contains(pr.AssignedReviewers, reviewer) {
+		t.Fatalf("expected %s to be replaced, got %+v", reviewer, pr)
+	}
+	if n := f.auditCount(t, entity.AuditActionPullRequestReassigned); n != 1 {
+		t.Fatalf("expected 1 reassignment audit event, got %d", n)
+	}
+}
+
+func ifMatch(ctx context.Context, prID string, version int64) context.Context {
+	return requestctx.WithIfMatch(ctx, entity.AuditEntityPullRequest, prID, version)
+}
+
+// brokenUserRepository имитирует отказ хранилища при чтении пользователя.
+type brokenUserRepository struct {
+	user.Repository
+	err error
+}
+
+func (r brokenUserRepository) WithDB(d db.DB) user.Repository {
+	return brokenUserRepository{r.Repository.WithDB(d), r.err}
+}
+
+func (r brokenUserRepository) GetByID(context.Context, string) (*entity.User, error) {
+	return nil, r.err
+}
+
+func TestService_GetPRsByReviewerKeepsStorageErrors(t *testing.T) {
+	store := memdb.New()
+	userRepo := memory.NewUserRepository(store)
+	newService := func(users user.Repository) *pullrequest.Service {
+		return pullrequest.NewService(memory.NewPullRequestRepository(store), users, memory.NewTeamRepository(store),
+			memory.NewAuditRepository(store), memory.NewOutboxRepository(store), store, eventbus.New(0),
+			pullrequest.Config{DefaultReviewSLA: time.Hour, EscalationBatchSize: 10})
+	}
+
+	errStorage := errors.New("connection reset")
+	_, _, err := newService(brokenUserRepository{userRepo, errStorage}).GetPRsByReviewer(context.Background(), "r1")
+	if !errors.Is(err, errStorage) || errors.Is(err, user.ErrUserNotFound) {
+		t.Fatalf("expected storage error, got %v", err)
+	}
+
+	_, _, err = newService(userRepo).GetPRsByReviewer(context.Background(), "ghost")
+	if !errors.Is(err, user.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound for unknown user, got %v", err)
+	}
+}
+
+func TestService_EscalateOverdueSkipsUnresolvable(t *testing.T) {
+	f := newFixture(t)
+	ctx := context.Background()
+
+	// в команде small оба ревьювера уже назначены, замены им нет
+	teams := team.NewService(memory.NewTeamRepository(f.store), memory.NewUserRepository(f.store),
+		f.audit, memory.NewOutboxRepository(f.store), f.store, eventbus.New(0))
+	_, err := teams.CreateTeam(ctx, entity.Team{TeamName: "small", Members: []entity.TeamMember{
+		{UserId: "s-author", Username: "S", IsActive: true},
+		{UserId: "s1", Username: "S1", IsActive: true},
+		{UserId: "s2", Username: "S2", IsActive: true},
+	}})
+	if err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+
+	// SLA в наносекунду делает все назначения просроченными сразу
+	const batch = 2
+	prs := pullrequest.NewService(f.prRepo, memory.NewUserRepository(f.store), memory.NewTeamRepository(f.store),
+		f.audit, memory.NewOutboxRepository(f.store), f.store, eventbus.New(0),
+		pullrequest.Config{DefaultReviewSLA: time.Nanosecond, EscalationBatchSize: batch})
+
+	// застрявших назначений больше, чем помещается в пакет
+	for i := range batch + 1 {
+		id := fmt.Sprintf("stuck-%d", i)
+		if _, err := prs.Create(ctx, entity.PullRequest{PullRequestId: id, PullRequestName: id, AuthorId: "s-author"}); err != nil {
+			t.Fatalf("create %s: %v", id, err)
+		}
+	}
+	created, err := prs.Create(ctx, entity.PullRequest{PullRequestId: "pr-1", PullRequestName: "Feature", AuthorId: "author"})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	escalate := func() {
+		t.Helper()
+		err := db.WithTx(ctx, f.store, func(ctx context.Context, tx db.Tx) error {
+			return prs.EscalateOverdue(db.WithoutTx(ctx), tx)
+		})
+		if err != nil {
+			t.Fatalf("escalate: %v", err)
+		}
+	}
+	for range batch + 2 {
+		escalate()
+	}
+
+	pr, err := prs.Get(ctx, "pr-1")
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if slices.Equal(pr.AssignedReviewers, created.AssignedReviewers) {
+		t.Fatalf("pr-1 must be escalated past unresolvable reviews, reviewers %v", pr.AssignedReviewers)
+	}
+	if n := f.auditCount(t, entity.AuditActionPullRequestEscalated); n == 0 {
+		t.Fatal("expected escalation audit events")
+	}
+}
@@ -0,0 +1,83 @@
+package reminder
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	// ListStale возвращает назначения на открытые PR, созданные не позже
+	// waitingBefore, по которым не напоминали после remindedBefore.
+	ListStale(ctx context.Context, waitingBefore time.Time, remindedBefore time.Time, limit uint64) ([]entity.StaleReview, error)
+	MarkReminded(ctx context.Context, prID string, reviewerID string, remindedAt time.Time) error
+}
+
+type Config struct {
+	// SLA — сколько PR может ждать ревьювера до первого напоминания
+	SLA time.Duration
+	// Interval — пауза между повторными напоминаниями одному ревьюверу
+	Interval  time.Duration
+	BatchSize uint64
+}
+
+// Service находит зависшие ревью и публикует по ним ReviewReminder. Сами
+// уведомления отправляет получатель outbox с учётом настроек команды и
+// пользователя.
+type Service struct {
+	reminderRepo Repository
+	outboxRepo   outbox.Repository
+	cfg          Config
+}
+
+func NewService(reminderRepo Repository, outboxRepo outbox.Repository, cfg Config) *Service {
+	return &Service{
+		reminderRepo: reminderRepo,
+		outboxRepo:   outboxRepo,
+		cfg:          cfg,
+	}
+}
+
+// RemindStale должен выполняться в транзакции tx: отметка о напоминании и
+// событие в outbox фиксируются вместе.
+func (s *Service) RemindStale(ctx context.Context, tx db.Tx) error {
+	txReminderRepo := s.reminderRepo.WithDB(tx)
+	txOutboxRepo := s.outboxRepo.WithDB(tx)
+
+	now := time.Now().UTC()
+	stale, err := txReminderRepo.ListStale(ctx, now.Add(-s.cfg.SLA), now.Add(-s.cfg.Interval), s.cfg.BatchSize)
+	if err != nil {
+		return fmt.Errorf("list stale reviews: %w", err)
+	}
+
+	events := make([]event.Event, 0, len(stale))
+	for _, review := range stale {
+		if err := txReminderRepo.MarkReminded(ctx, review.PullRequestID, review.ReviewerID, now); err != nil {
+			return fmt.Errorf("mark %s reminded for %s: %w", review.ReviewerID, review.PullRequestID, err)
+		}
+
+		events = append(events, event.ReviewReminder{
+			PullRequestID:   review.PullRequestID,
+			PullRequestName: review.PullRequestName,
+			ReviewerID:      review.ReviewerID,
+			TeamName:        review.TeamName,
+			WaitingSince:    review.WaitingSince,
+			RemindedAt:      now,
+		})
+	}
+
+	if err := outbox.Publish(ctx, txOutboxRepo, events...); err != nil {
+		return fmt.Errorf("publish reminders: %w", err)
+	}
+
+	if len(events) > 0 {
+		log.Printf("Sent %d review reminders", len(events))
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package scheduler
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	// TryLock берёт транзакционную advisory-блокировку по имени задачи и
+	// возвращает false, если её уже держит другая реплика. Вызывать в транзакции.
+	TryLock(ctx context.Context, jobName string) (bool, error)
+	// LastRun возвращает время последнего успешного запуска или nil.
+	LastRun(ctx context.Context, jobName string) (*time.Time, error)
+	SetLastRun(ctx context.Context, jobName string, at time.Time) error
+}
+
+// Job выполняется в транзакции, которая держит блокировку задачи. Контекст
+// Run не несёт эту транзакцию: в ней работает только то, что задача явно
+// выполняет через tx, а её db.WithTx открывают собственные транзакции.
+type Job struct {
+	Name string
+	// Schedule — выражение cron из пяти полей, допускается префикс CRON_TZ=
+	Schedule string
+	Run      func(ctx context.Context, tx db.Tx) error
+}
+
+type scheduledJob struct {
+	Job
+	schedule cron.Schedule
+}
+
+// Scheduler запускает задачи по расписанию на всех репликах, но выполняет
+// каждое срабатывание только одна из них: остальные не получают блокировку
+// либо видят, что текущее срабатывание уже обработано.
+type Scheduler struct {
+	schedulerRepo Repository
+	txProvider    db.Transactional
+	jobs          []scheduledJob
+}
+
+func New(schedulerRepo Repository, txProvider db.Transactional, jobs ...Job) (*Scheduler, error) {
+	scheduled := make([]scheduledJob, 0, len(jobs))
+	for _, job := range jobs {
+		schedule, err := cron.ParseStandard(job.Schedule)
+		if err != nil {
+			return nil, fmt.Errorf("parse schedule of %s: %w", job.Name, err)
+		}
+		scheduled = append(scheduled, scheduledJob{Job: job, schedule: schedule})
+	}
+
+	return &Scheduler{
+		schedulerRepo: schedulerRepo,
+		txProvider:    txProvider,
+		jobs:          scheduled,
+	}, nil
+}
+
+// Run запускает задачи до отмены ctx и дожидается завершения выполняющихся.
+func (s *Scheduler) Run(ctx context.Context) {
+	logger := cron.PrintfLogger(log.Default())
+	c := cron.New(cron.WithChain(cron.Recover(logger), cron.SkipIfStillRunning(logger)))
+	for _, job := range s.jobs {
+		c.Schedule(job.schedule, cron.FuncJob(func() {
+			if _, err := s.RunJob(ctx, job.Name); err != nil && !errors.Is(err, context.Canceled) {
+				log.Printf("ERROR: Scheduled job %s failed: %v", job.Name, err)
+			}
+		}))
+	}
+
+	log.Printf("Scheduler started: jobs=%d", len(s.jobs))
+	c.Start()
+	<-ctx.Done()
+	<-c.Stop().Done()
+	log.Println("Scheduler stopped")
+}
+
+// RunJob выполняет задачу, если её очередное срабатывание ещё не обработано
+// другой репликой. Возвращает true, если задача была выполнена.
+func (s *Scheduler) RunJob(ctx context.Context, name string) (bool, error) {
+	var job *scheduledJob
+	for i := range s.jobs {
+		if s.jobs[i].Name == name {
+			job = &s.jobs[i]
+		}
+	}
+	if job == nil {
+		return false, fmt.Errorf("unknown job %q", name)
+	}
+
+	var ran bool
+	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, tx db.Tx) error {
+		txRepo := s.schedulerRepo.WithDB(tx)
+
+		locked, err := txRepo.TryLock(ctx, job.Name)
+		if err != nil {
+			return fmt.Errorf("lock: %w", err)
+		}
+		if !locked {
+			log.Printf("Scheduler: job %s is running on another replica", job.Name)
+			return nil
+		}
+
+		now := time.Now()
+		lastRun, err := txRepo.LastRun(ctx, job.Name)
+		if err != nil {
+			return fmt.Errorf("get last run: %w", err)
+		}
+		if lastRun != nil && job.schedule.Next(*lastRun).After(now) {
+			return nil
+		}
+
+		if err := job.Run(db.WithoutTx(ctx), tx); err != nil {
+			return err
+		}
+		if err := txRepo.SetLastRun(ctx, job.Name, now.UTC()); err != nil {
+			return fmt.Errorf("set last run: %w", err)
+		}
+
+		ran = true
+		return nil
+	})
+
+	return ran, err
+}
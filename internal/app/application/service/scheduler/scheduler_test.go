@@ -0,0 +1,121 @@
+package scheduler_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/scheduler"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeTx struct{ db.DB }
+
+func (fakeTx) Commit(context.Context) error   { return nil }
+func (fakeTx) Rollback(context.Context) error { return nil }
+
+type fakeRepo struct {
+	locked  bool
+	lastRun map[string]time.Time
+}
+
+func (r *fakeRepo) WithDB(db.DB) scheduler.Repository { return r }
+
+func (r *fakeRepo) BeginTx(context.Context) (db.Tx, error) { return fakeTx{}, nil }
+
+func (r *fakeRepo) TryLock(context.Context, string) (bool, error) { return !r.locked, nil }
+
+func (r *fakeRepo) LastRun(_ context.Context, name string) (*time.Time, error) {
+	if at, ok := r.lastRun[name]; ok {
+		return &at, nil
+	}
+	return nil, nil
+}
+
+func (r *fakeRepo) SetLastRun(_ context.Context, name string, at time.Time) error {
+	r.lastRun[name] = at
+	return nil
+}
+
+func newScheduler(t *testing.T, repo *fakeRepo, runs *int) *scheduler.Scheduler {
+	t.Helper()
+	s, err := scheduler.New(repo, repo, scheduler.Job{
+		Name:     "digest",
+		Schedule: "0 9 * * *",
+		Run: func(context.Context, db.Tx) error {
+			*runs++
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("new scheduler: %v", err)
+	}
+	return s
+}
+
+func TestScheduler_RunsDueJobOnce(t *testing.T) {
+	repo := &fakeRepo{lastRun: map[string]time.Time{}}
+	var runs int
+	s := newScheduler(t, repo, &runs)
+
+	ran, err := s.RunJob(context.Background(), "digest")
+	if err != nil || !ran {
+		t.Fatalf("expected first run, got ran=%v err=%v", ran, err)
+	}
+
+	// вторая реплика на том же срабатывании видит, что оно уже обработано
+	ran, err = s.RunJob(context.Background(), "digest")
+	if err != nil || ran {
+		t.Fatalf("expected second run to be skipped, got ran=%v err=%v", ran, err)
+	}
+	if runs != 1 {
+		t.Fatalf("expected job to run once, ran %d times", runs)
+	}
+}
+
+func TestScheduler_RunsAgainAfterNextFireTime(t *testing.T) {
+	repo := &fakeRepo{lastRun: map[string]time.Time{"digest": time.Now().Add(-48 * time.Hour)}}
+	var runs int
+	s := newScheduler(t, repo, &runs)
+
+	if ran, err := s.RunJob(context.Background(), "digest"); err != nil || !ran {
+		t.Fatalf("expected overdue job to run, got ran=%v err=%v", ran, err)
+	}
+}
+
+func TestScheduler_SkipsWhenLockedByAnotherReplica(t *testing.T) {
+	repo := &fakeRepo{locked: true, lastRun: map[string]time.Time{}}
+	var runs int
+	s := newScheduler(t, repo, &runs)
+
+	ran, err := s.RunJob(context.Background(), "digest")
+	if err != nil || ran || runs != 0 {
+		t.Fatalf("expected locked job to be skipped, got ran=%v runs=%d err=%v", ran, runs, err)
+	}
+}
+
+func TestScheduler_RejectsInvalidSchedule(t *testing.T) {
+	repo := &fakeRepo{lastRun: map[string]time.Time{}}
+	if _, err := scheduler.New(repo, repo, scheduler.Job{Name: "bad", Schedule: "every day"}); err == nil {
+		t.Fatal("expected invalid schedule to be rejected")
+	}
+}
+
+func TestScheduler_RunStopsOnCancel(t *testing.T) {
+	repo := &fakeRepo{lastRun: map[string]time.Time{}}
+	var runs int
+	s := newScheduler(t, repo, &runs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("scheduler did not stop after cancel")
+	}
+}
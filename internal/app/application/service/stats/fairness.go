@@ -0,0 +1,159 @@
+package stats
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+)
+
+var (
+	ErrTeamNotFound     = errors.New("team not found or has no members")
+	ErrInvalidTolerance = errors.New("fairness tolerance must be positive")
+)
+
+// Fairness строит отчёт о равномерности распределения ревью в команде за окно.
+// Идеальная доля участника пропорциональна числу дней, когда он был активен.
+// nil tolerance означает значение из конфигурации.
+func (s *Service) Fairness(ctx context.Context, teamName string, window entity.StatsWindow, tolerance *float64) (entity.FairnessReport, error) {
+	tol := s.cfg.FairnessTolerance
+	if tolerance != nil {
+		tol = *tolerance
+	}
+	if tol <= 0 || math.IsNaN(tol) {
+		return entity.FairnessReport{}, ErrInvalidTolerance
+	}
+
+	window, err := s.resolveWindow(window)
+	if err != nil {
+		return entity.FairnessReport{}, err
+	}
+
+	members, err := s.statsRepo.MemberAssignments(ctx, window, teamName)
+	if err != nil {
+		return entity.FairnessReport{}, fmt.Errorf("member assignments: %w", err)
+	}
+	if len(members) == 0 {
+		return entity.FairnessReport{}, ErrTeamNotFound
+	}
+
+	changes, err := s.statsRepo.ActivityChanges(ctx, teamName, window.To)
+	if err != nil {
+		return entity.FairnessReport{}, fmt.Errorf("activity changes: %w", err)
+	}
+
+	byUser := make(map[string][]entity.ActivityChange)
+	for _, c := range changes {
+		byUser[c.UserID] = append(byUser[c.UserID], c)
+	}
+
+	report := entity.FairnessReport{
+		TeamName:  teamName,
+		Window:    window,
+		Tolerance: tol,
+		Members:   make([]entity.FairnessMember, len(members)),
+		Outliers:  []string{},
+	}
+
+	var totalDays float64
+	for i, m := range members {
+		report.Members[i] = entity.FairnessMember{
+			UserID:      m.UserID,
+			Username:    m.Username,
+			IsActive:    m.IsActive,
+			ActiveDays:  activeDays(byUser[m.UserID], m.IsActive, window),
+			Assignments: m.Assignments,
+		}
+		totalDays += report.Members[i].ActiveDays
+		report.TotalAssignments += m.Assignments
+	}
+
+	var rates []float64
+	for i := range report.Members {
+		m := &report.Members[i]
+		if totalDays > 0 {
+			m.IdealShare = m.ActiveDays / totalDays
+		}
+		if report.TotalAssignments > 0 {
+			m.Share = float64(m.Assignments) / float64(report.TotalAssignments)
+		}
+
+		switch {
+		case m.IdealShare > 0:
+			deviation := (m.Share - m.IdealShare) / m.IdealShare
+			m.Deviation = &deviation
+			m.WithinTolerance = math.Abs(deviation) <= tol
+		default:
+			m.WithinTolerance = m.Share == 0
+		}
+		if !m.WithinTolerance {
+			report.Outliers = append(report.Outliers, m.UserID)
+		}
+
+		if m.ActiveDays > 0 {
+			rates = append(rates, float64(m.Assignments)/m.ActiveDays)
+		}
+	}
+
+	report.Gini = gini(rates)
+	if len(rates) > 0 {
+		if lo := slices.Min(rates); lo > 0 {
+			ratio := slices.Max(rates) / lo
+			report.MaxMinRatio = &ratio
+		}
+	}
+
+	return report, nil
+}
+
+// activeDays считает дни активности в окне по истории is_active, отсортированной
+// по времени. До первой записи состояние считается противоположным ей, без
+// записей — равным текущему.
+func activeDays(changes []entity.ActivityChange, current bool, window entity.StatsWindow) float64 {
+	active := current
+	if len(changes) > 0 {
+		active = !changes[0].IsActive
+	}
+
+	var total time.Duration
+	since := window.From
+	for _, c := range changes {
+		if c.ChangedAt.After(window.From) {
+			if active {
+				total += c.ChangedAt.Sub(since)
+			}
+			since = c.ChangedAt
+		}
+		active = c.IsActive
+	}
+	if active {
+		total += window.To.Sub(since)
+	}
+
+	return total.Hours() / 24
+}
+
+// gini — коэффициент Джини: 0 при полностью равной нагрузке, ближе к 1 — когда
+// вся нагрузка у одного участника.
+func gini(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	var sum, diffs float64
+	for _, x := range values {
+		sum += x
+		for _, y := range values {
+			diffs += math.Abs(x - y)
+		}
+	}
+	if sum == 0 {
+		return 0
+	}
+
+	n := float64(len(values))
+	return diffs / (2 * n * sum)
+}
@@ -0,0 +1,143 @@
+package stats_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/stats"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+type fakeRepo struct {
+	members []entity.MemberAssignments
+	changes []entity.ActivityChange
+}
+
+func (r *fakeRepo) WithDB(db.DB) stats.Repository { return r }
+
+func (r *fakeRepo) BeginTx(context.Context) (db.Tx, error) { return nil, errors.New("not supported") }
+
+func (r *fakeRepo) ReviewerStats(context.Context, entity.StatsWindow, string) ([]entity.ReviewerStats, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) TeamStats(context.Context, entity.StatsWindow) ([]entity.TeamStats, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) CycleTime(context.Context, entity.StatsWindow, entity.CycleTimeGroup) ([]entity.CycleTimeBucket, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) MemberAssignments(context.Context, entity.StatsWindow, string) ([]entity.MemberAssignments, error) {
+	return r.members, nil
+}
+
+func (r *fakeRepo) ActivityChanges(context.Context, string, time.Time) ([]entity.ActivityChange, error) {
+	return r.changes, nil
+}
+
+var window = entity.StatsWindow{
+	From: time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC),
+	To:   time.Date(2025, 11, 11, 0, 0, 0, 0, time.UTC),
+}
+
+func newService(repo *fakeRepo) *stats.Service {
+	return stats.NewService(repo, stats.Config{DefaultWindow: 240 * time.Hour, FairnessTolerance: 0.25})
+}
+
+func TestFairness_EvenDistribution(t *testing.T) {
+	repo := &fakeRepo{members: []entity.MemberAssignments{
+		{UserID: "u1", IsActive: true, Assignments: 5},
+		{UserID: "u2", IsActive: true, Assignments: 5},
+	}}
+
+	report, err := newService(repo).Fairness(context.Background(), "backend", window, nil)
+	if err != nil {
+		t.Fatalf("fairness: %v", err)
+	}
+
+	if report.Gini != 0 {
+		t.Fatalf("expected gini 0, got %v", report.Gini)
+	}
+	if report.MaxMinRatio == nil || *report.MaxMinRatio != 1 {
+		t.Fatalf("expected max/min ratio 1, got %v", report.MaxMinRatio)
+	}
+	if len(report.Outliers) != 0 {
+		t.Fatalf("expected no outliers, got %v", report.Outliers)
+	}
+}
+
+func TestFairness_AccountsForActiveDays(t *testing.T) {
+	// u2 был активен только последние 5 дней из 10, поэтому половина
+	// нагрузки u1 для него — честная доля
+	repo := &fakeRepo{
+		members: []entity.MemberAssignments{
+			{UserID: "u1", IsActive: true, Assignments: 8},
+			{UserID: "u2", IsActive: true, Assignments: 4},
+			{UserID: "u3", IsActive: false, Assignments: 0},
+		},
+		changes: []entity.ActivityChange{
+			{UserID: "u2", IsActive: true, ChangedAt: window.From.Add(5 * 24 * time.Hour)},
+		},
+	}
+
+	report, err := newService(repo).Fairness(context.Background(), "backend", window, nil)
+	if err != nil {
+		t.Fatalf("fairness: %v", err)
+	}
+
+	u2 := report.Members[1]
+	if u2.ActiveDays != 5 {
+		t.Fatalf("expected 5 active days for u2, got %v", u2.ActiveDays)
+	}
+	if math.Abs(u2.IdealShare-1.0/3) > 1e-9 || !u2.WithinTolerance {
+		t.Fatalf("expected u2 ideal share 1/3 within tolerance, got %+v", u2)
+	}
+	if report.Members[2].ActiveDays != 0 || !report.Members[2].WithinTolerance {
+		t.Fatalf("expected inactive u3 to be ignored, got %+v", report.Members[2])
+	}
+	if math.Abs(report.Gini) > 1e-9 {
+		t.Fatalf("expected equal per-day load, got gini %v", report.Gini)
+	}
+}
+
+func TestFairness_ReportsOutliers(t *testing.T) {
+	repo := &fakeRepo{members: []entity.MemberAssignments{
+		{UserID: "u1", IsActive: true, Assignments: 9},
+		{UserID: "u2", IsActive: true, Assignments: 1},
+		{UserID: "u3", IsActive: true, Assignments: 0},
+	}}
+
+	report, err := newService(repo).Fairness(context.Background(), "backend", window, nil)
+	if err != nil {
+		t.Fatalf("fairness: %v", err)
+	}
+
+	if !slices.Equal(report.Outliers, []string{"u1", "u2", "u3"}) {
+		t.Fatalf("expected all members to be outliers, got %v", report.Outliers)
+	}
+	if report.MaxMinRatio != nil {
+		t.Fatalf("expected unbounded ratio with idle member, got %v", *report.MaxMinRatio)
+	}
+	if report.Gini <= 0.5 {
+		t.Fatalf("expected skewed gini, got %v", report.Gini)
+	}
+}
+
+func TestFairness_Errors(t *testing.T) {
+	s := newService(&fakeRepo{})
+
+	if _, err := s.Fairness(context.Background(), "ghost", window, nil); !errors.Is(err, stats.ErrTeamNotFound) {
+		t.Fatalf("expected ErrTeamNotFound, got %v", err)
+	}
+
+	zero := 0.0
+	if _, err := s.Fairness(context.Background(), "backend", window, &zero); !errors.Is(err, stats.ErrInvalidTolerance) {
+		t.Fatalf("expected ErrInvalidTolerance, got %v", err)
+	}
+}
@@ -0,0 +1,113 @@
+package stats
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	// ReviewerStats возвращает статистику по каждому пользователю; пустой
+	// teamName означает все команды.
+	ReviewerStats(ctx context.Context, window entity.StatsWindow, teamName string) ([]entity.ReviewerStats, error)
+	TeamStats(ctx context.Context, window entity.StatsWindow) ([]entity.TeamStats, error)
+	// MemberAssignments возвращает всех текущих участников команды, включая
+	// тех, у кого не было назначений.
+	MemberAssignments(ctx context.Context, window entity.StatsWindow, teamName string) ([]entity.MemberAssignments, error)
+	// ActivityChanges возвращает историю is_active участников команды до
+	// before, упорядоченную по времени.
+	ActivityChanges(ctx context.Context, teamName string, before time.Time) ([]entity.ActivityChange, error)
+	// CycleTime группирует PR, слитые в окне, по group и неделе слияния.
+	CycleTime(ctx context.Context, window entity.StatsWindow, group entity.CycleTimeGroup) ([]entity.CycleTimeBucket, error)
+}
+
+var (
+	ErrInvalidWindow = errors.New("stats window start must be before its end")
+	ErrInvalidGroup  = errors.New("unknown cycle time grouping")
+)
+
+type Config struct {
+	// DefaultWindow — длина окна, если границы не переданы.
+	DefaultWindow time.Duration
+	// FairnessTolerance — допустимое относительное отклонение доли ревью
+	// участника от идеальной.
+	FairnessTolerance float64
+}
+
+type Service struct {
+	statsRepo Repository
+	cfg       Config
+}
+
+func NewService(statsRepo Repository, cfg Config) *Service {
+	return &Service{
+		statsRepo: statsRepo,
+		cfg:       cfg,
+	}
+}
+
+func (s *Service) ReviewerStats(ctx context.Context, window entity.StatsWindow, teamName string) (entity.StatsWindow, []entity.ReviewerStats, error) {
+	window, err := s.resolveWindow(window)
+	if err != nil {
+		return entity.StatsWindow{}, nil, err
+	}
+
+	stats, err := s.statsRepo.ReviewerStats(ctx, window, teamName)
+	if err != nil {
+		return entity.StatsWindow{}, nil, fmt.Errorf("reviewer stats: %w", err)
+	}
+
+	return window, stats, nil
+}
+
+func (s *Service) TeamStats(ctx context.Context, window entity.StatsWindow) (entity.StatsWindow, []entity.TeamStats, error) {
+	window, err := s.resolveWindow(window)
+	if err != nil {
+		return entity.StatsWindow{}, nil, err
+	}
+
+	stats, err := s.statsRepo.TeamStats(ctx, window)
+	if err != nil {
+		return entity.StatsWindow{}, nil, fmt.Errorf("team stats: %w", err)
+	}
+
+	return window, stats, nil
+}
+
+func (s *Service) CycleTime(ctx context.Context, window entity.StatsWindow, group entity.CycleTimeGroup) (entity.StatsWindow, []entity.CycleTimeBucket, error) {
+	if !group.Valid() {
+		return entity.StatsWindow{}, nil, ErrInvalidGroup
+	}
+
+	window, err := s.resolveWindow(window)
+	if err != nil {
+		return entity.StatsWindow{}, nil, err
+	}
+
+	buckets, err := s.statsRepo.CycleTime(ctx, window, group)
+	if err != nil {
+		return entity.StatsWindow{}, nil, fmt.Errorf("cycle time: %w", err)
+	}
+
+	return window, buckets, nil
+}
+
+// resolveWindow подставляет границы по умолчанию: To — текущий момент,
+// From — To минус DefaultWindow.
+func (s *Service) resolveWindow(window entity.StatsWindow) (entity.StatsWindow, error) {
+	if window.To.IsZero() {
+		window.To = time.Now().UTC()
+	}
+	if window.From.IsZero() {
+		window.From = window.To.Add(-s.cfg.DefaultWindow)
+	}
+	if !window.From.Before(window.To) {
+		return entity.StatsWindow{}, ErrInvalidWindow
+	}
+
+	return window, nil
+}
@@ -0,0 +1,276 @@
+package team
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ImportFormat — формат файла массового импорта команд.
+type ImportFormat string
+
+const (
+	ImportCSV  ImportFormat = "csv"
+	ImportYAML ImportFormat = "yaml"
+)
+
+var (
+	ErrInvalidImport       = errors.New("invalid import file")
+	ErrUnknownImportFormat = errors.New("unknown import format")
+)
+
+// ImportError перечисляет все найденные в файле ошибки, чтобы их можно было
+// исправить за один проход.
+type ImportError struct {
+	Problems []string
+}
+
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrInvalidImport, strings.Join(e.Problems, "; "))
+}
+
+func (e *ImportError) Unwrap() error {
+	return ErrInvalidImport
+}
+
+var csvHeader = []string{"team_name", "user_id", "username", "is_active"}
+
+// ParseImport читает файл импорта. В CSV строки одной команды объединяются
+// в порядке первого появления; пустой is_active в обоих форматах означает true.
+func ParseImport(r io.Reader, format ImportFormat) ([]entity.Team, error) {
+	switch format {
+	case ImportCSV:
+		return parseImportCSV(r)
+	case ImportYAML:
+		return parseImportYAML(r)
+	default:
+		return nil, ErrUnknownImportFormat
+	}
+}
+
+func parseImportCSV(r io.Reader) ([]entity.Team, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, &ImportError{Problems: []string{fmt.Sprintf("read header: %v", err)}}
+	}
+	for i, column := range csvHeader {
+		if strings.TrimSpace(header[i]) != column {
+			return nil, &ImportError{Problems: []string{
+				fmt.Sprintf("header must be %s", strings.Join(csvHeader, ",")),
+			}}
+		}
+	}
+
+	var teams []entity.Team
+	index := make(map[string]int)
+	var problems []string
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// ошибки разбора CSV (кавычки, число колонок) не дают продолжить чтение надёжно
+			return nil, &ImportError{Problems: append(problems, err.Error())}
+		}
+		line, _ := reader.FieldPos(0)
+
+		isActive, err := parseIsActive(record[3])
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: invalid is_active %q", line, record[3]))
+			continue
+		}
+
+		teamName := strings.TrimSpace(record[0])
+		i, ok := index[teamName]
+		if !ok {
+			i = len(teams)
+			index[teamName] = i
+			teams = append(teams, entity.Team{TeamName: teamName})
+		}
+		teams[i].Members = append(teams[i].Members, entity.TeamMember{
+			UserId:   strings.TrimSpace(record[1]),
+			Username: strings.TrimSpace(record[2]),
+			IsActive: isActive,
+		})
+	}
+	if len(problems) > 0 {
+		return nil, &ImportError{Problems: problems}
+	}
+
+	return teams, nil
+}
+
+func parseIsActive(value string) (bool, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return true, nil
+	}
+	return strconv.ParseBool(value)
+}
+
+type importFile struct {
+	Teams []struct {
+		TeamName string `yaml:"team_name"`
+		Members  []struct {
+			UserID   string `yaml:"user_id"`
+			Username string `yaml:"username"`
+			IsActive *bool  `yaml:"is_active"`
+		} `yaml:"members"`
+	} `yaml:"teams"`
+}
+
+func parseImportYAML(r io.Reader) ([]entity.Team, error) {
+	var file importFile
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, &ImportError{Problems: []string{err.Error()}}
+	}
+
+	teams := make([]entity.Team, len(file.Teams))
+	for i, t := range file.Teams {
+		teams[i].TeamName = strings.TrimSpace(t.TeamName)
+		teams[i].Members = make([]entity.TeamMember, len(t.Members))
+		for j, m := range t.Members {
+			teams[i].Members[j] = entity.TeamMember{
+				UserId:   strings.TrimSpace(m.UserID),
+				Username: strings.TrimSpace(m.Username),
+				IsActive: m.IsActive == nil || *m.IsActive,
+			}
+		}
+	}
+
+	return teams, nil
+}
+
+// validateImport проверяет файл целиком и возвращает все найденные ошибки.
+func validateImport(teams []entity.Team) []string {
+	if len(teams) == 0 {
+		return []string{"file contains no teams"}
+	}
+
+	var problems []string
+	seenTeams := make(map[string]bool)
+	userTeam := make(map[string]string)
+	for i, t := range teams {
+		label := t.TeamName
+		if label == "" {
+			label = fmt.Sprintf("#%d", i+1)
+			problems = append(problems, fmt.Sprintf("team %s: empty team_name", label))
+		} else if seenTeams[t.TeamName] {
+			problems = append(problems, fmt.Sprintf("team %s: listed more than once", label))
+		}
+		seenTeams[t.TeamName] = true
+
+		for _, m := range t.Members {
+			switch {
+			case m.UserId == "":
+				problems = append(problems, fmt.Sprintf("team %s: member with empty user_id", label))
+				continue
+			case m.Username == "":
+				problems = append(problems, fmt.Sprintf("user %s: empty username", m.UserId))
+			}
+
+			if other, ok := userTeam[m.UserId]; ok {
+				if other == t.TeamName {
+					problems = append(problems, fmt.Sprintf("user %s: listed twice in team %s", m.UserId, t.TeamName))
+				} else {
+					problems = append(problems, fmt.Sprintf("user %s: listed in both %s and %s", m.UserId, other, t.TeamName))
+				}
+				continue
+			}
+			userTeam[m.UserId] = t.TeamName
+		}
+	}
+
+	return problems
+}
+
+// Import проверяет файл, строит план изменений и, если это не dry run, применяет
+// его одной транзакцией: при любой ошибке база остаётся в исходном состоянии.
+// Пользователи, которых нет в файле, не затрагиваются.
+func (s *Service) Import(ctx context.Context, teams []entity.Team, dryRun bool) (entity.TeamImportPlan, error) {
+	if problems := validateImport(teams); len(problems) > 0 {
+		return entity.TeamImportPlan{}, &ImportError{Problems: problems}
+	}
+
+	var plan entity.TeamImportPlan
+	var events []event.Event
+
+	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, _ db.Tx) error {
+		changes, err := s.planImport(ctx, teams)
+		if err != nil {
+			return err
+		}
+		plan.Changes = changes
+		if dryRun {
+			return nil
+		}
+
+		for _, t := range teams {
+			teamEvents, err := s.upsertTeam(ctx, t)
+			if err != nil {
+				return fmt.Errorf("import team %s: %w", t.TeamName, err)
+			}
+			events = append(events, teamEvents...)
+		}
+		plan.Applied = true
+		return nil
+	})
+	if err != nil {
+		return entity.TeamImportPlan{}, err
+	}
+
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	return plan, nil
+}
+
+func (s *Service) planImport(ctx context.Context, teams []entity.Team) ([]entity.TeamImportChange, error) {
+	changes := make([]entity.TeamImportChange, 0)
+	for _, t := range teams {
+		exists, err := s.teamRepo.Exists(ctx, t.TeamName)
+		if err != nil {
+			return nil, fmt.Errorf("check team %s exists: %w", t.TeamName, err)
+		}
+		if !exists {
+			changes = append(changes, entity.TeamImportChange{Kind: entity.TeamImportCreateTeam, TeamName: t.TeamName})
+		}
+
+		for _, m := range t.Members {
+			existing, err := s.userRepo.GetByID(ctx, m.UserId)
+			if err != nil {
+				return nil, fmt.Errorf("get user %s: %w", m.UserId, err)
+			}
+
+			change := entity.TeamImportChange{TeamName: t.TeamName, UserID: m.UserId}
+			switch {
+			case existing == nil:
+				change.Kind = entity.TeamImportCreateUser
+			case existing.TeamName != t.TeamName:
+				change.Kind = entity.TeamImportMoveUser
+				change.FromTeamName = existing.TeamName
+			case existing.ToDomainTeamMember() != m:
+				change.Kind = entity.TeamImportUpdateUser
+			default:
+				continue
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
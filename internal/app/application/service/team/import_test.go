@@ -0,0 +1,118 @@
+package team_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseImport_CSVGroupsByTeam(t *testing.T) {
+	file := `team_name,user_id,username,is_active
+backend,u1,Alice,true
+frontend,u2,Bob,
+backend,u3,Carol,false
+`
+	teams, err := team.ParseImport(strings.NewReader(file), team.ImportCSV)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	want := []entity.Team{
+		{TeamName: "backend", Members: []entity.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u3", Username: "Carol", IsActive: false},
+		}},
+		{TeamName: "frontend", Members: []entity.TeamMember{
+			{UserId: "u2", Username: "Bob", IsActive: true},
+		}},
+	}
+	if !reflect.DeepEqual(teams, want) {
+		t.Fatalf("unexpected teams: %+v", teams)
+	}
+}
+
+func TestParseImport_YAML(t *testing.T) {
+	file := `teams:
+  - team_name: backend
+    members:
+      - user_id: u1
+        username: Alice
+      - user_id: u2
+        username: Bob
+        is_active: false
+`
+	teams, err := team.ParseImport(strings.NewReader(file), team.ImportYAML)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	want := []entity.Team{{TeamName: "backend", Members: []entity.TeamMember{
+		{UserId: "u1", Username: "Alice", IsActive: true},
+		{UserId: "u2", Username: "Bob", IsActive: false},
+	}}}
+	if !reflect.DeepEqual(teams, want) {
+		t.Fatalf("unexpected teams: %+v", teams)
+	}
+}
+
+func TestParseImport_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format team.ImportFormat
+		file   string
+	}{
+		{"wrong header", team.ImportCSV, "team,user\nbackend,u1\n"},
+		{"bad is_active", team.ImportCSV, "team_name,user_id,username,is_active\nbackend,u1,Alice,maybe\n"},
+		{"unknown yaml field", team.ImportYAML, "teams:\n  - name: backend\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := team.ParseImport(strings.NewReader(tt.file), tt.format)
+			if !errors.Is(err, team.ErrInvalidImport) {
+				t.Fatalf("expected ErrInvalidImport, got %v", err)
+			}
+		})
+	}
+
+	if _, err := team.ParseImport(strings.NewReader(""), "xml"); !errors.Is(err, team.ErrUnknownImportFormat) {
+		t.Fatalf("expected ErrUnknownImportFormat, got %v", err)
+	}
+}
+
+func TestImport_ReportsAllProblems(t *testing.T) {
+	// проверка выполняется до обращения к базе, поэтому репозитории не нужны
+	s := team.NewService(nil, nil, nil, nil, nil, nil)
+
+	_, err := s.Import(context.Background(), []entity.Team{
+		{TeamName: "backend", Members: []entity.TeamMember{
+			{UserId: "u1", Username: "Alice"},
+			{UserId: "u1", Username: "Alice"},
+		}},
+		{TeamName: "frontend", Members: []entity.TeamMember{
+			{UserId: "u1", Username: "Alice"},
+			{UserId: "u2"},
+		}},
+		{TeamName: "", Members: []entity.TeamMember{{UserId: ""}}},
+		{TeamName: "backend"},
+	}, true)
+
+	var importErr *team.ImportError
+	if !errors.As(err, &importErr) {
+		t.Fatalf("expected ImportError, got %v", err)
+	}
+	want := []string{
+		"user u1: listed twice in team backend",
+		"user u1: listed in both backend and frontend",
+		"user u2: empty username",
+		"team #3: empty team_name",
+		"team #3: member with empty user_id",
+		"team backend: listed more than once",
+	}
+	if !reflect.DeepEqual(importErr.Problems, want) {
+		t.Fatalf("unexpected problems:\n%s", strings.Join(importErr.Problems, "\n"))
+	}
+}
@@ -1,76 +1,194 @@
 package team
 
 import (
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
 	"avito-backend-intern-assignment/internal/app/application/service/user"
 	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"avito-backend-intern-assignment/internal/pkg/requestctx"
 	"avito-backend-intern-assignment/pkg/db"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 )
 
 type Repository interface {
 	db.TransactionalRepository[Repository]
 	Create(ctx context.Context, teamName string) error
 	Exists(ctx context.Context, teamName string) (bool, error)
+	List(ctx context.Context) ([]string, error)
+	// GetReviewSLA возвращает nil, если для команды SLA не задан.
+	GetReviewSLA(ctx context.Context, teamName string) (*time.Duration, error)
+	// SetReviewSLA увеличивает версию команды.
+	SetReviewSLA(ctx context.Context, teamName string, sla time.Duration) error
+	// Version возвращает версию команды; db.ErrNotFound, если команды нет.
+	Version(ctx context.Context, teamName string) (int64, error)
+	// LockVersion — Version с блокировкой команды до конца транзакции.
+	LockVersion(ctx context.Context, teamName string) (int64, error)
+	// BumpVersion увеличивает версию команды и возвращает новую.
+	BumpVersion(ctx context.Context, teamName string) (int64, error)
 }
 
 var (
 	ErrTeamUpdate        = errors.New("update team members")
 	ErrTeamAlreadyExists = errors.New("team already exists")
 	ErrTeamNotFound      = errors.New("team not found")
+	ErrInvalidReviewSLA  = errors.New("review sla must be at least one minute")
+	// ErrMemberConflict — участника одновременно создал или удалил другой
+	// запрос; повтор запроса увидит новое состояние.
+	ErrMemberConflict = errors.New("team member was changed by a concurrent request")
 )
 
 type Service struct {
 	teamRepo   Repository
 	userRepo   user.Repository
+	auditRepo  audit.Repository
+	outboxRepo outbox.Repository
 	txProvider db.Transactional
+	publisher  event.Publisher
 }
 
-func NewService(teamRepo Repository, userRepo user.Repository, txProvider db.Transactional) *Service {
+func NewService(
+	teamRepo Repository,
+	userRepo user.Repository,
+	auditRepo audit.Repository,
+	outboxRepo outbox.Repository,
+	txProvider db.Transactional,
+	publisher event.Publisher,
+) *Service {
 	return &Service{
 		teamRepo:   teamRepo,
 		userRepo:   userRepo,
+		auditRepo:  auditRepo,
+		outboxRepo: outboxRepo,
 		txProvider: txProvider,
+		publisher:  publisher,
 	}
 }
 
-func (s *Service) CreateTeam(ctx context.Context, team entity.Team) error {
-	return db.WithTx(ctx, s.txProvider, func(ctx context.Context, tx db.Tx) error {
-		txTeamRepo := s.teamRepo.WithDB(tx)
-		txUserRepo := s.userRepo.WithDB(tx)
+// CreateTeam создаёт или обновляет команду и возвращает её с новой версией.
+func (s *Service) CreateTeam(ctx context.Context, team entity.Team) (entity.Team, error) {
+	var events []event.Event
 
-		exists, err := txTeamRepo.Exists(ctx, team.TeamName)
+	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, _ db.Tx) error {
+		var err error
+		events, err = s.upsertTeam(ctx, team)
 		if err != nil {
-			return fmt.Errorf("check team exists: %w", err)
+			return err
 		}
 
-		if !exists {
-			if err := txTeamRepo.Create(ctx, team.TeamName); err != nil {
-				return fmt.Errorf("create team: %w", err)
+		team.Version, err = s.teamRepo.Version(ctx, team.TeamName)
+		if err != nil {
+			return fmt.Errorf("get team %s version: %w", team.TeamName, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return entity.Team{}, err
+	}
+
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	return team, nil
+}
+
+// upsertTeam создаёт команду при необходимости и создаёт или обновляет её участников.
+// Возвращает события, которые нужно отправить в шину после коммита.
+func (s *Service) upsertTeam(ctx context.Context, team entity.Team) ([]event.Event, error) {
+	var events []event.Event
+
+	exists, err := s.lockVersion(ctx, team.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		// изменение состава — изменение команды, даже если участники те же
+		if _, err := s.teamRepo.BumpVersion(ctx, team.TeamName); err != nil {
+			return nil, fmt.Errorf("bump team %s version: %w", team.TeamName, err)
+		}
+	} else {
+		// клиент ожидал увидеть команду, а её нет
+		if _, ok := requestctx.IfMatch(ctx, entity.AuditEntityTeam, team.TeamName); ok {
+			return nil, service.ErrVersionMismatch
+		}
+		if err := s.teamRepo.Create(ctx, team.TeamName); err != nil {
+			// параллельный запрос мог создать команду после проверки выше
+			if db.IsConstraint(err, db.ErrConflict, "teams_pkey") {
+				return nil, ErrTeamAlreadyExists
 			}
+			return nil, fmt.Errorf("create team: %w", err)
 		}
 
-		for _, tm := range team.Members {
-			userEntity := tm.ToDomainUser(team.TeamName)
-			existing, err := txUserRepo.GetByID(ctx, userEntity.UserId)
+		err := audit.Record(ctx, s.auditRepo, entity.AuditActionTeamCreated, entity.AuditEntityTeam, team.TeamName,
+			nil, entity.Team{TeamName: team.TeamName, Members: team.Members})
+		if err != nil {
+			return nil, fmt.Errorf("audit team %s: %w", team.TeamName, err)
+		}
+	}
+
+	for _, tm := range team.Members {
+		userEntity := tm.ToDomainUser(team.TeamName)
+		existing, err := s.userRepo.GetByID(ctx, userEntity.UserId)
+		if err != nil {
+			return nil, fmt.Errorf("get user %s: %w", userEntity.UserId, err)
+		}
+
+		if existing == nil {
+			if err := s.userRepo.Create(ctx, userEntity); err != nil {
+				return nil, memberError("create", userEntity.UserId, err)
+			}
+		} else {
+			if err := s.userRepo.Update(ctx, userEntity); err != nil {
+				return nil, memberError("update", userEntity.UserId, err)
+			}
+			// версия в аудите не считается изменением участника
+			userEntity.Version = existing.Version
+		}
+
+		// новый неактивный участник в историю не пишется: без записей отчёт
+		// о нагрузке считает, что состояние не менялось
+		activityChanged := (existing == nil && userEntity.IsActive) ||
+			(existing != nil && existing.IsActive != userEntity.IsActive)
+		if activityChanged {
+			if err := s.userRepo.RecordActivity(ctx, userEntity.UserId, userEntity.IsActive, time.Now().UTC()); err != nil {
+				return nil, memberError("record activity of", userEntity.UserId, err)
+			}
+		}
+
+		if existing == nil || *existing != userEntity {
+			err := audit.Record(ctx, s.auditRepo, entity.AuditActionMemberUpserted, entity.AuditEntityUser, userEntity.UserId,
+				existing, userEntity)
 			if err != nil {
-				return fmt.Errorf("get user %s: %w", userEntity.UserId, err)
+				return nil, fmt.Errorf("audit user %s: %w", userEntity.UserId, err)
 			}
+		}
 
-			if existing == nil {
-				if err := txUserRepo.Create(ctx, userEntity); err != nil {
-					return fmt.Errorf("create user %s: %w", userEntity.UserId, err)
-				}
-			} else {
-				if err := txUserRepo.Update(ctx, userEntity); err != nil {
-					return fmt.Errorf("update user %s: %w", userEntity.UserId, err)
-				}
+		if existing != nil && existing.IsActive && !userEntity.IsActive {
+			e := event.UserDeactivated{
+				UserID:        userEntity.UserId,
+				TeamName:      userEntity.TeamName,
+				DeactivatedAt: time.Now().UTC(),
 			}
+			if err := outbox.Publish(ctx, s.outboxRepo, e); err != nil {
+				return nil, fmt.Errorf("publish user %s deactivation: %w", userEntity.UserId, err)
+			}
+			events = append(events, e)
 		}
+	}
 
-		return nil
-	})
+	return events, nil
+}
+
+// memberError переводит нарушения ограничений при записи участника в
+// ErrMemberConflict: их вызывает только гонка с другим запросом.
+func memberError(op, userID string, err error) error {
+	if errors.Is(err, db.ErrConflict) || errors.Is(err, db.ErrForeignKey) {
+		return fmt.Errorf("%w: %s user %s: %w", ErrMemberConflict, op, userID, err)
+	}
+	return fmt.Errorf("%s user %s: %w", op, userID, err)
 }
 
 func (s *Service) GetTeamWithMembers(ctx context.Context, teamName string) (entity.Team, error) {
@@ -92,8 +210,67 @@ func (s *Service) GetTeamWithMembers(ctx context.Context, teamName string) (enti
 		members[i] = u.ToDomainTeamMember()
 	}
 
+	version, err := s.teamRepo.Version(ctx, teamName)
+	if err != nil {
+		return entity.Team{}, fmt.Errorf("get team %s version: %w", teamName, err)
+	}
+
 	return entity.Team{
 		TeamName: teamName,
 		Members:  members,
+		Version:  version,
 	}, nil
 }
+
+// lockVersion блокирует команду до конца транзакции и сверяет её версию с
+// If-Match запроса. Возвращает false, если команды нет.
+func (s *Service) lockVersion(ctx context.Context, teamName string) (bool, error) {
+	version, err := s.teamRepo.LockVersion(ctx, teamName)
+	if errors.Is(err, db.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("lock team %s: %w", teamName, err)
+	}
+	return true, service.CheckVersion(ctx, entity.AuditEntityTeam, teamName, version)
+}
+
+// SetReviewSLA задаёт время, за которое ревьювер команды должен вынести решение.
+func (s *Service) SetReviewSLA(ctx context.Context, teamName string, sla time.Duration) error {
+	if sla < time.Minute {
+		return ErrInvalidReviewSLA
+	}
+
+	return db.WithTx(ctx, s.txProvider, func(ctx context.Context, _ db.Tx) error {
+		exists, err := s.lockVersion(ctx, teamName)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrTeamNotFound
+		}
+
+		before, err := s.teamRepo.GetReviewSLA(ctx, teamName)
+		if err != nil {
+			return fmt.Errorf("get team %s review sla: %w", teamName, err)
+		}
+		if err := s.teamRepo.SetReviewSLA(ctx, teamName, sla); err != nil {
+			return fmt.Errorf("set team %s review sla: %w", teamName, err)
+		}
+
+		type reviewSLA struct {
+			ReviewSLAMinutes int64 `json:"review_sla_minutes"`
+		}
+		var beforeState any
+		if before != nil {
+			beforeState = reviewSLA{ReviewSLAMinutes: int64(*before / time.Minute)}
+		}
+		err = audit.Record(ctx, s.auditRepo, entity.AuditActionTeamReviewSLAChanged, entity.AuditEntityTeam, teamName,
+			beforeState, reviewSLA{ReviewSLAMinutes: int64(sla / time.Minute)})
+		if err != nil {
+			return fmt.Errorf("audit team %s: %w", teamName, err)
+		}
+
+		return nil
+	})
+}
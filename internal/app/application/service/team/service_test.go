@@ -0,0 +1,75 @@
+package team_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/memory"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"errors"
+	"testing"
+)
+
+// lateTeamRepository не видит команду при проверке, как будто параллельный
+// запрос создал её сразу после.
+type lateTeamRepository struct {
+	team.Repository
+}
+
+func (r lateTeamRepository) WithDB(d db.DB) team.Repository {
+	return lateTeamRepository{r.Repository.WithDB(d)}
+}
+
+func (r lateTeamRepository) LockVersion(context.Context, string) (int64, error) {
+	return 0, db.ErrNotFound
+}
+
+// lateUserRepository так же не видит существующих пользователей.
+type lateUserRepository struct {
+	user.Repository
+}
+
+func (r lateUserRepository) WithDB(d db.DB) user.Repository {
+	return lateUserRepository{r.Repository.WithDB(d)}
+}
+
+func (r lateUserRepository) GetByID(context.Context, string) (*entity.User, error) {
+	return nil, nil
+}
+
+func TestService_CreateTeamMapsConstraintViolations(t *testing.T) {
+	ctx := context.Background()
+	store := memdb.New()
+	teamRepo := memory.NewTeamRepository(store)
+	userRepo := memory.NewUserRepository(store)
+	auditRepo := memory.NewAuditRepository(store)
+	outboxRepo := memory.NewOutboxRepository(store)
+	bus := eventbus.New(0)
+
+	backend := entity.Team{TeamName: "backend", Members: []entity.TeamMember{{UserId: "u1", Username: "Alice", IsActive: true}}}
+	if _, err := team.NewService(teamRepo, userRepo, auditRepo, outboxRepo, store, bus).CreateTeam(ctx, backend); err != nil {
+		t.Fatalf("create team: %v", err)
+	}
+
+	racingTeams := team.NewService(lateTeamRepository{teamRepo}, userRepo, auditRepo, outboxRepo, store, bus)
+	if _, err := racingTeams.CreateTeam(ctx, backend); !errors.Is(err, team.ErrTeamAlreadyExists) {
+		t.Fatalf("expected ErrTeamAlreadyExists, got %v", err)
+	}
+
+	racingUsers := team.NewService(teamRepo, lateUserRepository{userRepo}, auditRepo, outboxRepo, store, bus)
+	if _, err := racingUsers.CreateTeam(ctx, backend); !errors.Is(err, team.ErrMemberConflict) {
+		t.Fatalf("expected ErrMemberConflict, got %v", err)
+	}
+
+	// неудачные попытки откатились целиком
+	got, err := team.NewService(teamRepo, userRepo, auditRepo, outboxRepo, store, bus).GetTeamWithMembers(ctx, "backend")
+	if err != nil {
+		t.Fatalf("get team: %v", err)
+	}
+	if got.Version != 1 || len(got.Members) != 1 {
+		t.Fatalf("unexpected team after failed upserts: %+v", got)
+	}
+}
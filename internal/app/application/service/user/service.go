@@ -2,11 +2,15 @@ package user
 
 import (
 	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
 	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
 	"avito-backend-intern-assignment/pkg/db"
 	"context"
 	"errors"
 	"fmt"
+	"time"
 )
 
 var ErrUserNotFound = errors.New("user not found")
@@ -14,42 +18,126 @@ var ErrUserNotFound = errors.New("user not found")
 type Repository interface {
 	db.TransactionalRepository[Repository]
 	Create(ctx context.Context, user entity.User) error
+	// Update увеличивает версию пользователя.
 	Update(ctx context.Context, user entity.User) error
+	// LockVersion блокирует пользователя до конца транзакции и возвращает его
+	// версию; db.ErrNotFound, если пользователя нет.
+	LockVersion(ctx context.Context, userID string) (int64, error)
 	GetByID(ctx context.Context, userID string) (*entity.User, error)
 	GetByTeam(ctx context.Context, teamName string) ([]entity.User, error)
+	List(ctx context.Context) ([]entity.User, error)
+	// RecordActivity сохраняет смену is_active; по этой истории считаются
+	// активные дни участника в отчёте о равномерности нагрузки.
+	RecordActivity(ctx context.Context, userID string, isActive bool, at time.Time) error
 }
 
 type Service struct {
-	usersRepo Repository
+	usersRepo  Repository
+	auditRepo  audit.Repository
+	outboxRepo outbox.Repository
+	txProvider db.Transactional
+	publisher  event.Publisher
 }
 
-func NewService(usersRepo Repository) *Service {
+func NewService(
+	usersRepo Repository,
+	auditRepo audit.Repository,
+	outboxRepo outbox.Repository,
+	txProvider db.Transactional,
+	publisher event.Publisher,
+) *Service {
 	return &Service{
-		usersRepo: usersRepo,
+		usersRepo:  usersRepo,
+		auditRepo:  auditRepo,
+		outboxRepo: outboxRepo,
+		txProvider: txProvider,
+		publisher:  publisher,
 	}
 }
 
 func (s *Service) SetIsActive(ctx context.Context, userID string, isActive bool) (entity.User, error) {
+	var updated entity.User
+	var events []event.Event
+
+	err := db.WithTx(ctx, s.txProvider, func(ctx context.Context, _ db.Tx) error {
+		version, err := s.usersRepo.LockVersion(ctx, userID)
+		if errors.Is(err, db.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("lock user: %w", err)
+		}
+		if err := service.CheckVersion(ctx, entity.AuditEntityUser, userID, version); err != nil {
+			return err
+		}
+
+		u, err := s.usersRepo.GetByID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("get user by id: %w", err)
+		}
+
+		if u == nil {
+			return ErrUserNotFound
+		}
+
+		before := *u
+		u.IsActive = isActive
+		if err := s.usersRepo.Update(ctx, *u); err != nil {
+			return err
+		}
+		u.Version++
+
+		if before.IsActive != u.IsActive {
+			if err := s.usersRepo.RecordActivity(ctx, u.UserId, u.IsActive, time.Now().UTC()); err != nil {
+				return fmt.Errorf("record user %s activity: %w", u.UserId, err)
+			}
+
+			err := audit.Record(ctx, s.auditRepo, entity.AuditActionUserActivityChanged, entity.AuditEntityUser, u.UserId,
+				before, *u)
+			if err != nil {
+				return fmt.Errorf("audit user %s: %w", u.UserId, err)
+			}
+		}
+
+		if before.IsActive && !u.IsActive {
+			events = append(events, event.UserDeactivated{
+				UserID:        u.UserId,
+				TeamName:      u.TeamName,
+				DeactivatedAt: time.Now().UTC(),
+			})
+			if err := outbox.Publish(ctx, s.outboxRepo, events...); err != nil {
+				return fmt.Errorf("publish user %s deactivation: %w", u.UserId, err)
+			}
+		}
+
+		updated = *u
+		return nil
+	})
+	if err != nil {
+		return entity.User{}, err
+	}
+
+	db.AfterCommit(ctx, func() { s.publisher.Publish(events...) })
+	return updated, nil
+}
+
+func (s *Service) Get(ctx context.Context, userID string) (entity.User, error) {
 	u, err := s.usersRepo.GetByID(ctx, userID)
 	if err != nil {
 		return entity.User{}, fmt.Errorf("get user by id: %w", err)
 	}
-
 	if u == nil {
 		return entity.User{}, ErrUserNotFound
 	}
-
-	u.IsActive = isActive
-	err = s.usersRepo.Update(ctx, *u)
-	if err != nil {
-		return entity.User{}, err
-	}
-
 	return *u, nil
 }
 
 func (s *Service) UpdateReposDB(db db.TransactionalDB) service.User {
 	return &Service{
-		usersRepo: s.usersRepo.WithDB(db),
+		usersRepo:  s.usersRepo.WithDB(db),
+		auditRepo:  s.auditRepo.WithDB(db),
+		outboxRepo: s.outboxRepo.WithDB(db),
+		txProvider: db,
+		publisher:  s.publisher,
 	}
 }
@@ -0,0 +1,158 @@
+package vcs
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service"
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+type IdentityRepository interface {
+	db.TransactionalRepository[IdentityRepository]
+	Upsert(ctx context.Context, identity entity.VCSIdentity) error
+	// ResolveUserID возвращает пустую строку, если логин не сопоставлен.
+	ResolveUserID(ctx context.Context, provider entity.VCSProvider, login string) (string, error)
+	ListByUser(ctx context.Context, userID string) ([]entity.VCSIdentity, error)
+}
+
+var (
+	ErrIdentityNotMapped = errors.New("vcs login is not mapped to a user")
+	ErrInvalidIdentity   = errors.New("vcs identity must have a known provider and a login")
+)
+
+type Service struct {
+	identityRepo IdentityRepository
+	userRepo     user.Repository
+	prService    service.PullRequest
+}
+
+func NewService(identityRepo IdentityRepository, userRepo user.Repository, prService service.PullRequest) *Service {
+	return &Service{
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		prService:    prService,
+	}
+}
+
+// PullRequestID строит идентификатор PR в нашем сервисе по его координатам в VCS,
+// например github:acme/api#42.
+func PullRequestID(provider entity.VCSProvider, repository string, number int64) string {
+	return fmt.Sprintf("%s:%s#%d", provider, repository, number)
+}
+
+// HandlePullRequestEvent применяет событие VCS к PR. Повторная доставка того же
+// события безопасна: уже применённые переходы возвращают VCSSyncIgnored.
+func (s *Service) HandlePullRequestEvent(ctx context.Context, e entity.VCSPullRequestEvent) (entity.VCSSyncResult, error) {
+	prID := PullRequestID(e.Provider, e.Repository, e.Number)
+	result := entity.VCSSyncResult{PullRequestID: prID, Outcome: entity.VCSSyncIgnored}
+
+	log.Printf("VCS event: provider=%s, action=%s, pr=%s", e.Provider, e.Action, prID)
+
+	var err error
+	switch e.Action {
+	case entity.VCSActionOpened:
+		err = s.open(ctx, prID, e)
+		result.Outcome = entity.VCSSyncCreated
+	case entity.VCSActionMerged:
+		result.Outcome, err = s.transition(ctx, prID, entity.PullRequestStatusMERGED, entity.VCSSyncMerged, s.prService.MarkMerged)
+	case entity.VCSActionClosed:
+		result.Outcome, err = s.transition(ctx, prID, entity.PullRequestStatusCLOSED, entity.VCSSyncClosed, s.prService.Close)
+	case entity.VCSActionReopened:
+		result.Outcome, err = s.transition(ctx, prID, entity.PullRequestStatusOPEN, entity.VCSSyncReopened, s.prService.Reopen)
+	default:
+		return result, nil
+	}
+
+	switch {
+	case err == nil:
+		return result, nil
+	case errors.Is(err, pullrequest.ErrPullRequestExists),
+		errors.Is(err, pullrequest.ErrPullRequestNotFound),
+		errors.Is(err, pullrequest.ErrPullRequestMerged):
+		log.Printf("VCS event for %s ignored: %v", prID, err)
+		result.Outcome = entity.VCSSyncIgnored
+		return result, nil
+	default:
+		return result, err
+	}
+}
+
+// transition переводит PR в status через apply. Если PR уже в нём — например,
+// событие доставлено повторно, — возвращает VCSSyncIgnored, а не outcome.
+func (s *Service) transition(
+	ctx context.Context,
+	prID string,
+	status entity.PRStatus,
+	outcome entity.VCSSyncOutcome,
+	apply func(ctx context.Context, prID string) (*entity.PullRequest, error),
+) (entity.VCSSyncOutcome, error) {
+	before, err := s.prService.Get(ctx, prID)
+	if err != nil {
+		return entity.VCSSyncIgnored, err
+	}
+	if before.Status == status {
+		return entity.VCSSyncIgnored, nil
+	}
+
+	after, err := apply(ctx, prID)
+	if err != nil {
+		return entity.VCSSyncIgnored, err
+	}
+	if after.Status != status {
+		return entity.VCSSyncIgnored, nil
+	}
+	return outcome, nil
+}
+
+func (s *Service) open(ctx context.Context, prID string, e entity.VCSPullRequestEvent) error {
+	authorID, err := s.identityRepo.ResolveUserID(ctx, e.Provider, e.AuthorLogin)
+	if err != nil {
+		return fmt.Errorf("resolve author %s: %w", e.AuthorLogin, err)
+	}
+	if authorID == "" {
+		return fmt.Errorf("%w: %s/%s", ErrIdentityNotMapped, e.Provider, e.AuthorLogin)
+	}
+
+	_, err = s.prService.Create(ctx, entity.PullRequest{
+		PullRequestId:   prID,
+		PullRequestName: e.Title,
+		AuthorId:        authorID,
+	})
+	return err
+}
+
+func (s *Service) LinkIdentity(ctx context.Context, identity entity.VCSIdentity) (entity.VCSIdentity, error) {
+	if identity.Login == "" ||
+		(identity.Provider != entity.VCSProviderGitHub && identity.Provider != entity.VCSProviderGitLab) {
+		return entity.VCSIdentity{}, ErrInvalidIdentity
+	}
+
+	u, err := s.userRepo.GetByID(ctx, identity.UserID)
+	if err != nil {
+		return entity.VCSIdentity{}, fmt.Errorf("get user: %w", err)
+	}
+	if u == nil {
+		return entity.VCSIdentity{}, user.ErrUserNotFound
+	}
+
+	identity.CreatedAt = time.Now().UTC()
+	if err := s.identityRepo.Upsert(ctx, identity); err != nil {
+		return entity.VCSIdentity{}, fmt.Errorf("upsert identity: %w", err)
+	}
+
+	return identity, nil
+}
+
+func (s *Service) ListIdentities(ctx context.Context, userID string) ([]entity.VCSIdentity, error) {
+	identities, err := s.identityRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list identities: %w", err)
+	}
+	return identities, nil
+}
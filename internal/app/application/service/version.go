@@ -0,0 +1,21 @@
+package service
+
+import (
+	"avito-backend-intern-assignment/internal/pkg/requestctx"
+	"context"
+	"errors"
+)
+
+// ErrVersionMismatch — сущность изменилась после того, как клиент получил
+// её ETag (заголовок If-Match).
+var ErrVersionMismatch = errors.New("entity version does not match If-Match")
+
+// CheckVersion сверяет текущую версию сущности с If-Match запроса. Версию
+// нужно получить под блокировкой строки, иначе проверка гоняется с
+// параллельными изменениями.
+func CheckVersion(ctx context.Context, entityType string, entityID string, version int64) error {
+	if expected, ok := requestctx.IfMatch(ctx, entityType, entityID); ok && expected != version {
+		return ErrVersionMismatch
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	SignatureHeader  = "X-Signature"
+	EventHeader      = "X-Webhook-Event"
+	DeliveryHeader   = "X-Webhook-Delivery"
+	TimestampHeader  = "X-Webhook-Timestamp"
+	signaturePrefix  = "sha256="
+	maxResponseBytes = 4 << 10
+)
+
+// Sign возвращает значение заголовка X-Signature: HMAC-SHA256 от тела запроса
+// в hex с префиксом "sha256=".
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify проверяет подпись в постоянное время. Пригодится получателям,
+// написанным на Go, и тестам.
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+type Request struct {
+	URL        string
+	Secret     string
+	EventType  string
+	DeliveryID int64
+	Body       []byte
+}
+
+// Client отправляет подписанные запросы подписчикам.
+type Client struct {
+	httpClient *http.Client
+}
+
+func NewClient(timeout time.Duration) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send возвращает HTTP-статус ответа (0, если ответа не было) и ошибку, если
+// доставка не удалась: сетевая ошибка или статус вне диапазона 2xx.
+func (c *Client) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(SignatureHeader, Sign(req.Secret, req.Body))
+	httpReq.Header.Set(EventHeader, req.EventType)
+	httpReq.Header.Set(DeliveryHeader, strconv.FormatInt(req.DeliveryID, 10))
+	httpReq.Header.Set(TimestampHeader, strconv.FormatInt(time.Now().Unix(), 10))
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,79 @@
+package webhook_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/webhook"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClient_Send_SignsBody(t *testing.T) {
+	const secret = "s3cr3t"
+	body := []byte(`{"event_type":"pull_request.reviewer_assigned"}`)
+
+	received := make(chan *http.Request, 1)
+	receivedBody := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		received <- r
+		receivedBody <- data
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	client := webhook.NewClient(time.Second)
+	status, err := client.Send(context.Background(), webhook.Request{
+		URL:        srv.URL,
+		Secret:     secret,
+		EventType:  "pull_request.reviewer_assigned",
+		DeliveryID: 7,
+		Body:       body,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Fatalf("expected status 204, got %d", status)
+	}
+
+	r := <-received
+	data := <-receivedBody
+	if string(data) != string(body) {
+		t.Fatalf("body mismatch: %s", data)
+	}
+	if !webhook.Verify(secret, data, r.Header.Get(webhook.SignatureHeader)) {
+		t.Fatalf("signature %q does not match body", r.Header.Get(webhook.SignatureHeader))
+	}
+	if r.Header.Get(webhook.EventHeader) != "pull_request.reviewer_assigned" {
+		t.Fatalf("unexpected event header %q", r.Header.Get(webhook.EventHeader))
+	}
+	if r.Header.Get(webhook.DeliveryHeader) != "7" {
+		t.Fatalf("unexpected delivery header %q", r.Header.Get(webhook.DeliveryHeader))
+	}
+}
+
+func TestClient_Send_NonSuccessStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := webhook.NewClient(time.Second)
+	status, err := client.Send(context.Background(), webhook.Request{URL: srv.URL, Secret: "x", Body: []byte(`{}`)})
+	if err == nil {
+		t.Fatal("expected error for 503 response")
+	}
+	if status != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d", status)
+	}
+}
+
+func TestVerify_RejectsWrongSecret(t *testing.T) {
+	body := []byte(`{"a":1}`)
+	if webhook.Verify("other", body, webhook.Sign("secret", body)) {
+		t.Fatal("signature with another secret must not verify")
+	}
+}
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+type DelivererConfig struct {
+	PollInterval time.Duration
+	BatchSize    uint64
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+}
+
+// Deliverer отправляет накопленные доставки с экспоненциальной задержкой между
+// попытками. После MaxAttempts неудач доставка переводится в статус DEAD.
+type Deliverer struct {
+	webhookRepo Repository
+	txProvider  db.Transactional
+	client      *Client
+	cfg         DelivererConfig
+}
+
+func NewDeliverer(webhookRepo Repository, txProvider db.Transactional, client *Client, cfg DelivererConfig) *Deliverer {
+	return &Deliverer{
+		webhookRepo: webhookRepo,
+		txProvider:  txProvider,
+		client:      client,
+		cfg:         cfg,
+	}
+}
+
+func (d *Deliverer) Run(ctx context.Context) {
+	log.Printf("Webhook deliverer started: poll=%s, max attempts=%d", d.cfg.PollInterval, d.cfg.MaxAttempts)
+
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := d.DeliverBatch(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("ERROR: Webhook delivery batch failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Println("Webhook deliverer stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeliverBatch выполняет одну пачку доставок и возвращает их количество.
+func (d *Deliverer) DeliverBatch(ctx context.Context) (int, error) {
+	var processed int
+
+	err := db.WithTx(ctx, d.txProvider, func(ctx context.Context, tx db.Tx) error {
+		txRepo := d.webhookRepo.WithDB(tx)
+
+		deliveries, err := txRepo.FetchDueDeliveries(ctx, time.Now().UTC(), d.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("fetch due deliveries: %w", err)
+		}
+
+		for _, delivery := range deliveries {
+			sub, err := txRepo.GetSubscription(ctx, delivery.SubscriptionID)
+			if err != nil {
+				return fmt.Errorf("get subscription %d: %w", delivery.SubscriptionID, err)
+			}
+			if sub == nil {
+				continue
+			}
+
+			d.attempt(ctx, *sub, &delivery)
+			if err := txRepo.UpdateDelivery(ctx, delivery); err != nil {
+				return fmt.Errorf("update delivery %d: %w", delivery.ID, err)
+			}
+			processed++
+		}
+
+		return nil
+	})
+
+	return processed, err
+}
+
+func (d *Deliverer) attempt(ctx context.Context, sub entity.WebhookSubscription, delivery *entity.WebhookDelivery) {
+	status, err := d.client.Send(ctx, Request{
+		URL:        sub.URL,
+		Secret:     sub.Secret,
+		EventType:  delivery.EventType,
+		DeliveryID: delivery.ID,
+		Body:       delivery.Payload,
+	})
+
+	now := time.Now().UTC()
+	delivery.Attempts++
+	if status != 0 {
+		delivery.ResponseStatus = &status
+	}
+
+	if err == nil {
+		delivery.Status = entity.WebhookDeliveryDelivered
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+		return
+	}
+
+	delivery.LastError = err.Error()
+	if delivery.Attempts >= d.cfg.MaxAttempts {
+		delivery.Status = entity.WebhookDeliveryDead
+		log.Printf("ERROR: Webhook delivery %d to %s is dead after %d attempts: %v",
+			delivery.ID, sub.URL, delivery.Attempts, err)
+		return
+	}
+
+	delivery.NextAttemptAt = now.Add(d.backoff(delivery.Attempts))
+	log.Printf("Webhook delivery %d to %s failed (attempt %d), retry at %s: %v",
+		delivery.ID, sub.URL, delivery.Attempts, delivery.NextAttemptAt.Format(time.RFC3339), err)
+}
+
+func (d *Deliverer) backoff(attempts int) time.Duration {
+	backoff := d.cfg.BaseBackoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+	return backoff
+}
@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/domain/event"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+)
+
+const (
+	DefaultDeliveriesLimit = 100
+	MaxDeliveriesLimit     = 1000
+)
+
+type Repository interface {
+	db.TransactionalRepository[Repository]
+	CreateSubscription(ctx context.Context, sub entity.WebhookSubscription) (int64, error)
+	GetSubscription(ctx context.Context, id int64) (*entity.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context, teamName string) ([]entity.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	// ListSubscribers возвращает подписки команды, включающие указанный тип события.
+	ListSubscribers(ctx context.Context, teamName string, eventType string) ([]entity.WebhookSubscription, error)
+
+	// EnqueueDeliveries игнорирует уже существующие пары (subscription_id, outbox_message_id),
+	// что делает повторную обработку одного сообщения outbox безопасной.
+	EnqueueDeliveries(ctx context.Context, deliveries ...entity.WebhookDelivery) error
+	// FetchDueDeliveries блокирует (FOR UPDATE SKIP LOCKED) и возвращает доставки
+	// в статусе PENDING, время очередной попытки которых наступило. Вызывать в транзакции.
+	FetchDueDeliveries(ctx context.Context, now time.Time, limit uint64) ([]entity.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery entity.WebhookDelivery) error
+	ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error)
+}
+
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrInvalidURL           = errors.New("webhook url must be an absolute http(s) url")
+	ErrInvalidEventTypes    = errors.New("webhook event types are empty or unknown")
+)
+
+type Service struct {
+	webhookRepo Repository
+	teamRepo    team.Repository
+}
+
+func NewService(webhookRepo Repository, teamRepo team.Repository) *Service {
+	return &Service{
+		webhookRepo: webhookRepo,
+		teamRepo:    teamRepo,
+	}
+}
+
+// Subscribe регистрирует подписку. Если секрет не передан, он генерируется и
+// возвращается в ответе — это единственный момент, когда его можно получить.
+func (s *Service) Subscribe(ctx context.Context, sub entity.WebhookSubscription) (entity.WebhookSubscription, error) {
+	parsed, err := url.Parse(sub.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return entity.WebhookSubscription{}, ErrInvalidURL
+	}
+
+	if len(sub.EventTypes) == 0 {
+		return entity.WebhookSubscription{}, ErrInvalidEventTypes
+	}
+	for _, t := range sub.EventTypes {
+		if !event.IsKnownType(t) {
+			return entity.WebhookSubscription{}, ErrInvalidEventTypes
+		}
+	}
+	slices.Sort(sub.EventTypes)
+	sub.EventTypes = slices.Compact(sub.EventTypes)
+
+	exists, err := s.teamRepo.Exists(ctx, sub.TeamName)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf("check team exists: %w", err)
+	}
+	if !exists {
+		return entity.WebhookSubscription{}, team.ErrTeamNotFound
+	}
+
+	if sub.Secret == "" {
+		if sub.Secret, err = generateSecret(); err != nil {
+			return entity.WebhookSubscription{}, fmt.Errorf("generate secret: %w", err)
+		}
+	}
+	sub.CreatedAt = time.Now().UTC()
+
+	id, err := s.webhookRepo.CreateSubscription(ctx, sub)
+	if err != nil {
+		return entity.WebhookSubscription{}, fmt.Errorf("create subscription: %w", err)
+	}
+	sub.ID = id
+
+	return sub, nil
+}
+
+func (s *Service) ListSubscriptions(ctx context.Context, teamName string) ([]entity.WebhookSubscription, error) {
+	subs, err := s.webhookRepo.ListSubscriptions(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("list subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+func (s *Service) Unsubscribe(ctx context.Context, id int64) error {
+	sub, err := s.webhookRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return fmt.Errorf("get subscription: %w", err)
+	}
+	if sub == nil {
+		return ErrSubscriptionNotFound
+	}
+
+	if err := s.webhookRepo.DeleteSubscription(ctx, id); err != nil {
+		return fmt.Errorf("delete subscription: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	sub, err := s.webhookRepo.GetSubscription(ctx, filter.SubscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("get subscription: %w", err)
+	}
+	if sub == nil {
+		return nil, ErrSubscriptionNotFound
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = DefaultDeliveriesLimit
+	}
+	if filter.Limit > MaxDeliveriesLimit {
+		filter.Limit = MaxDeliveriesLimit
+	}
+
+	deliveries, err := s.webhookRepo.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func generateSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Envelope — тело запроса, которое получает подписчик.
+type Envelope struct {
+	EventID    int64           `json:"event_id"`
+	EventType  string          `json:"event_type"`
+	TeamName   string          `json:"team_name"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Sink раскладывает события outbox по подпискам команды, создавая записи
+// в журнале доставок. Сама отправка выполняется Deliverer-ом.
+type Sink struct {
+	webhookRepo Repository
+}
+
+func NewSink(webhookRepo Repository) *Sink {
+	return &Sink{
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (s *Sink) Name() string { return "webhook" }
+
+func (s *Sink) Deliver(ctx context.Context, msg entity.OutboxMessage) error {
+	if msg.TeamName == "" {
+		return nil
+	}
+
+	subs, err := s.webhookRepo.ListSubscribers(ctx, msg.TeamName, msg.EventType)
+	if err != nil {
+		return fmt.Errorf("list subscribers: %w", err)
+	}
+	if len(subs) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(Envelope{
+		EventID:    msg.ID,
+		EventType:  msg.EventType,
+		TeamName:   msg.TeamName,
+		OccurredAt: msg.CreatedAt,
+		Data:       msg.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal envelope: %w", err)
+	}
+
+	now := time.Now().UTC()
+	deliveries := make([]entity.WebhookDelivery, 0, len(subs))
+	for _, sub := range subs {
+		deliveries = append(deliveries, entity.WebhookDelivery{
+			SubscriptionID:  sub.ID,
+			OutboxMessageID: msg.ID,
+			EventType:       msg.EventType,
+			Payload:         body,
+			Status:          entity.WebhookDeliveryPending,
+			NextAttemptAt:   now,
+			CreatedAt:       now,
+		})
+	}
+
+	if err := s.webhookRepo.EnqueueDeliveries(ctx, deliveries...); err != nil {
+		return fmt.Errorf("enqueue deliveries: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionTeamCreated           AuditAction = "team.created"
+	AuditActionTeamReviewSLAChanged  AuditAction = "team.review_sla_changed"
+	AuditActionMemberUpserted        AuditAction = "team.member_upserted"
+	AuditActionUserActivityChanged   AuditAction = "user.activity_changed"
+	AuditActionPullRequestCreated    AuditAction = "pull_request.created"
+	AuditActionPullRequestMerged     AuditAction = "pull_request.merged"
+	AuditActionPullRequestReassigned AuditAction = "pull_request.reviewer_reassigned"
+	AuditActionPullRequestClosed     AuditAction = "pull_request.closed"
+	AuditActionPullRequestReopened   AuditAction = "pull_request.reopened"
+	AuditActionPullRequestReviewed   AuditAction = "pull_request.review_submitted"
+	AuditActionPullRequestEscalated  AuditAction = "pull_request.reviewer_escalated"
+)
+
+const (
+	AuditEntityTeam        = "team"
+	AuditEntityUser        = "user"
+	AuditEntityPullRequest = "pull_request"
+)
+
+type AuditEvent struct {
+	ID         int64
+	OccurredAt time.Time
+	Actor      string
+	Action     AuditAction
+	EntityType string
+	EntityID   string
+	Before     json.RawMessage
+	After      json.RawMessage
+	RequestID  string
+}
+
+type AuditFilter struct {
+	Actor      string
+	Action     AuditAction
+	EntityType string
+	EntityID   string
+	RequestID  string
+	From       *time.Time
+	To         *time.Time
+	Limit      uint64
+	Offset     uint64
+}
@@ -0,0 +1,53 @@
+package entity
+
+import "time"
+
+// DirectoryUser — сотрудник во внешнем каталоге (SCIM). UserName становится
+// идентификатором пользователя в сервисе.
+type DirectoryUser struct {
+	ID          string
+	UserName    string
+	DisplayName string
+	Active      bool
+}
+
+// DirectoryGroup — группа каталога, соответствует команде с тем же именем.
+type DirectoryGroup struct {
+	ID          string
+	DisplayName string
+	// MemberIDs содержит DirectoryUser.ID участников.
+	MemberIDs []string
+}
+
+type Directory struct {
+	Users  []DirectoryUser
+	Groups []DirectoryGroup
+}
+
+// DirectoryReassignment — ревью, снятое с деактивированного сотрудника.
+// NewReviewerID пуст в dry run.
+type DirectoryReassignment struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+}
+
+// DirectorySyncReport описывает расхождение сервиса с каталогом и то, что
+// синхронизация с ним сделала.
+type DirectorySyncReport struct {
+	Applied  bool
+	SyncedAt time.Time
+	// Changes — создания, обновления и переводы между командами.
+	Changes []TeamImportChange
+	// Deactivated — активные пользователи, которых больше нет в каталоге
+	// или которые выключены в нём.
+	Deactivated []string
+	Reassigned  []DirectoryReassignment
+	// Unresolved — расхождения, которые синхронизация не может исправить сама.
+	Unresolved []string
+}
+
+// InSync сообщает, что сервис полностью совпадает с каталогом.
+func (r DirectorySyncReport) InSync() bool {
+	return len(r.Changes) == 0 && len(r.Deactivated) == 0 && len(r.Reassigned) == 0 && len(r.Unresolved) == 0
+}
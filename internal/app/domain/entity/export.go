@@ -0,0 +1,41 @@
+package entity
+
+// ExportDataset — таблица, которую можно выгрузить целиком.
+type ExportDataset string
+
+const (
+	ExportTeams         ExportDataset = "teams"
+	ExportUsers         ExportDataset = "users"
+	ExportPullRequests  ExportDataset = "pull_requests"
+	ExportAssignments   ExportDataset = "assignments"
+	ExportReassignments ExportDataset = "reassignments"
+)
+
+var exportColumns = map[ExportDataset][]string{
+	ExportTeams:         {"team_name", "review_sla_minutes"},
+	ExportUsers:         {"user_id", "username", "team_name", "is_active"},
+	ExportPullRequests:  {"pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at"},
+	ExportAssignments:   {"pull_request_id", "reviewer_id", "assigned_at", "decision", "decided_at"},
+	ExportReassignments: {"pull_request_id", "old_reviewer_id", "new_reviewer_id", "assigned_at", "reassigned_at"},
+}
+
+func (d ExportDataset) Valid() bool {
+	_, ok := exportColumns[d]
+	return ok
+}
+
+// Columns возвращает имена колонок выгрузки в порядке значений строки.
+func (d ExportDataset) Columns() []string {
+	return exportColumns[d]
+}
+
+type ExportFormat string
+
+const (
+	ExportCSV    ExportFormat = "csv"
+	ExportNDJSON ExportFormat = "ndjson"
+)
+
+func (f ExportFormat) Valid() bool {
+	return f == ExportCSV || f == ExportNDJSON
+}
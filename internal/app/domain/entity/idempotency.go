@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// IdempotencyRecord — запрос с заголовком Idempotency-Key и его ответ. Пока
+// запрос выполняется, StatusCode равен нулю.
+type IdempotencyRecord struct {
+	Route       string
+	Key         string
+	Fingerprint string
+	// Token — владелец резервирования: завершить или освободить ключ может
+	// только запрос, который его занял, а не тот, чью зависшую запись
+	// перезаписали.
+	Token       string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
+
+func (r IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+type NotificationChannel string
+
+const (
+	NotificationChannelChat  NotificationChannel = "chat"
+	NotificationChannelEmail NotificationChannel = "email"
+)
+
+type NotificationMode string
+
+const (
+	NotificationModeInstant NotificationMode = "INSTANT"
+	NotificationModeDigest  NotificationMode = "DIGEST"
+	NotificationModeOff     NotificationMode = "OFF"
+)
+
+func (m NotificationMode) Valid() bool {
+	switch m {
+	case NotificationModeInstant, NotificationModeDigest, NotificationModeOff:
+		return true
+	}
+	return false
+}
+
+type TeamNotificationSettings struct {
+	TeamName       string
+	ChatWebhookURL string
+	Mode           NotificationMode
+	UpdatedAt      time.Time
+}
+
+type UserNotificationPreferences struct {
+	UserID    string
+	Email     string
+	Mode      NotificationMode
+	UpdatedAt time.Time
+}
+
+// Notification — сообщение одному получателю канала: URL вебхука чата или
+// email-адрес. Для режима DIGEST SentAt остаётся пустым до отправки сводки.
+type Notification struct {
+	ID              int64
+	OutboxMessageID int64
+	Channel         NotificationChannel
+	Recipient       string
+	Mode            NotificationMode
+	Subject         string
+	Body            string
+	CreatedAt       time.Time
+	SentAt          *time.Time
+}
@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type OutboxMessage struct {
+	ID            int64
+	EventType     string
+	AggregateID   string
+	TeamName      string
+	Payload       json.RawMessage
+	CreatedAt     time.Time
+	Attempts      int
+	NextAttemptAt time.Time
+	DeliveredAt   *time.Time
+	LastError     string
+}
@@ -7,14 +7,21 @@ type PRStatus string
 const (
 	PullRequestStatusMERGED PRStatus = "MERGED"
 	PullRequestStatusOPEN   PRStatus = "OPEN"
+	PullRequestStatusCLOSED PRStatus = "CLOSED"
 )
 
 type PullRequest struct {
-	AssignedReviewers []string
-	AuthorId          string
-	CreatedAt         *time.Time
-	MergedAt          *time.Time
-	PullRequestId     string
-	PullRequestName   string
-	Status            PRStatus
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"created_at"`
+	MergedAt          *time.Time `json:"merged_at"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	Status            PRStatus   `json:"status"`
+
+	Reviews   []ReviewAssignment `json:"reviews,omitempty"`
+	SLAStatus SLAStatus          `json:"sla_status,omitempty"`
+
+	// Version растёт при каждом изменении PR, отдаётся клиентам как ETag.
+	Version int64 `json:"-"`
 }
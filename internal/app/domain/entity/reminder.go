@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// StaleReview — назначение ревьювера на открытый PR, по которому пора напомнить.
+type StaleReview struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	TeamName        string
+	ReviewerID      string
+	WaitingSince    time.Time
+}
@@ -0,0 +1,64 @@
+package entity
+
+import "time"
+
+type ReviewDecision string
+
+const (
+	ReviewDecisionApproved         ReviewDecision = "APPROVED"
+	ReviewDecisionChangesRequested ReviewDecision = "CHANGES_REQUESTED"
+)
+
+func (d ReviewDecision) Valid() bool {
+	return d == ReviewDecisionApproved || d == ReviewDecisionChangesRequested
+}
+
+type SLAStatus string
+
+const (
+	SLAStatusOnTime   SLAStatus = "on_time"
+	SLAStatusAtRisk   SLAStatus = "at_risk"
+	SLAStatusBreached SLAStatus = "breached"
+)
+
+// ReviewAssignment — назначение ревьювера на PR. Decision пуст, пока ревьювер
+// не вынес решение.
+type ReviewAssignment struct {
+	ReviewerID string         `json:"reviewer_id"`
+	AssignedAt time.Time      `json:"assigned_at"`
+	Decision   ReviewDecision `json:"decision,omitempty"`
+	DecidedAt  *time.Time     `json:"decided_at,omitempty"`
+}
+
+// OverdueReview — назначение без решения, превысившее SLA команды.
+type OverdueReview struct {
+	PullRequestID string
+	ReviewerID    string
+	TeamName      string
+	AssignedAt    time.Time
+}
+
+// EvaluateReviewSLA оценивает самое давнее ожидающее решения назначение PR:
+// at_risk — прошло не меньше 3/4 SLA, breached — SLA истёк. Для PR не в
+// статусе OPEN возвращается пустой статус.
+func EvaluateReviewSLA(pr PullRequest, sla time.Duration, now time.Time) SLAStatus {
+	if pr.Status != PullRequestStatusOPEN {
+		return ""
+	}
+
+	var waited time.Duration
+	for _, review := range pr.Reviews {
+		if review.Decision == "" {
+			waited = max(waited, now.Sub(review.AssignedAt))
+		}
+	}
+
+	switch {
+	case waited >= sla:
+		return SLAStatusBreached
+	case waited >= sla/4*3:
+		return SLAStatusAtRisk
+	default:
+		return SLAStatusOnTime
+	}
+}
@@ -0,0 +1,67 @@
+package entity_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"testing"
+	"time"
+)
+
+func TestEvaluateReviewSLA(t *testing.T) {
+	now := time.Date(2025, 11, 26, 12, 0, 0, 0, time.UTC)
+	decidedAt := now.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		status  entity.PRStatus
+		reviews []entity.ReviewAssignment
+		want    entity.SLAStatus
+	}{
+		{
+			name:    "fresh assignment",
+			status:  entity.PullRequestStatusOPEN,
+			reviews: []entity.ReviewAssignment{{ReviewerID: "u2", AssignedAt: now.Add(-time.Hour)}},
+			want:    entity.SLAStatusOnTime,
+		},
+		{
+			name:    "three quarters of sla",
+			status:  entity.PullRequestStatusOPEN,
+			reviews: []entity.ReviewAssignment{{ReviewerID: "u2", AssignedAt: now.Add(-18 * time.Hour)}},
+			want:    entity.SLAStatusAtRisk,
+		},
+		{
+			name:   "oldest pending wins",
+			status: entity.PullRequestStatusOPEN,
+			reviews: []entity.ReviewAssignment{
+				{ReviewerID: "u2", AssignedAt: now.Add(-time.Hour)},
+				{ReviewerID: "u3", AssignedAt: now.Add(-25 * time.Hour)},
+			},
+			want: entity.SLAStatusBreached,
+		},
+		{
+			name:   "decided reviews are ignored",
+			status: entity.PullRequestStatusOPEN,
+			reviews: []entity.ReviewAssignment{{
+				ReviewerID: "u2",
+				AssignedAt: now.Add(-48 * time.Hour),
+				Decision:   entity.ReviewDecisionApproved,
+				DecidedAt:  &decidedAt,
+			}},
+			want: entity.SLAStatusOnTime,
+		},
+		{
+			name:    "merged pr has no status",
+			status:  entity.PullRequestStatusMERGED,
+			reviews: []entity.ReviewAssignment{{ReviewerID: "u2", AssignedAt: now.Add(-48 * time.Hour)}},
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pr := entity.PullRequest{Status: tt.status, Reviews: tt.reviews}
+			if got := entity.EvaluateReviewSLA(pr, 24*time.Hour, now); got != tt.want {
+				t.Fatalf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package entity
+
+import "time"
+
+// StatsWindow — полуинтервал [From, To), за который считается статистика.
+type StatsWindow struct {
+	From time.Time
+	To   time.Time
+}
+
+// ReviewStats — показатели ревью за окно. OpenAssignments отражает текущее
+// состояние и от окна не зависит.
+type ReviewStats struct {
+	OpenAssignments   int
+	TotalAssignments  int
+	ReassignedAway    int
+	MergedReviewed    int
+	MedianTimeToMerge *time.Duration
+}
+
+type ReviewerStats struct {
+	UserID   string
+	Username string
+	TeamName string
+	ReviewStats
+}
+
+type TeamStats struct {
+	TeamName string
+	ReviewStats
+}
+
+// MemberAssignments — число назначений участника команды за окно.
+type MemberAssignments struct {
+	UserID      string
+	Username    string
+	IsActive    bool
+	Assignments int
+}
+
+// ActivityChange — запись истории is_active пользователя.
+type ActivityChange struct {
+	UserID    string
+	IsActive  bool
+	ChangedAt time.Time
+}
+
+type FairnessMember struct {
+	UserID      string
+	Username    string
+	IsActive    bool
+	ActiveDays  float64
+	Assignments int
+	Share       float64
+	IdealShare  float64
+	// Deviation — относительное отклонение доли от идеальной; nil, если
+	// идеальная доля нулевая.
+	Deviation       *float64
+	WithinTolerance bool
+}
+
+// FairnessReport описывает, насколько равномерно распределены ревью в команде
+// с учётом дней, когда участники были активны.
+type FairnessReport struct {
+	TeamName         string
+	Window           StatsWindow
+	Tolerance        float64
+	TotalAssignments int
+	Gini             float64
+	// MaxMinRatio — отношение максимальной нагрузки в день к минимальной;
+	// nil, если у кого-то из активных участников нет назначений.
+	MaxMinRatio *float64
+	Members     []FairnessMember
+	Outliers    []string
+}
+
+// CycleTimeGroup — разрез, по которому группируется время цикла PR.
+type CycleTimeGroup string
+
+const (
+	CycleTimeByTeam     CycleTimeGroup = "team"
+	CycleTimeByAuthor   CycleTimeGroup = "author"
+	CycleTimeByReviewer CycleTimeGroup = "reviewer"
+)
+
+func (g CycleTimeGroup) Valid() bool {
+	return g == CycleTimeByTeam || g == CycleTimeByAuthor || g == CycleTimeByReviewer
+}
+
+type Percentiles struct {
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+}
+
+// CycleTimeBucket — показатели слитых за неделю PR одной группы. CycleTime
+// считается от создания до слияния, FirstReview — от создания до первого
+// решения ревьювера и отсутствует, если решений не было.
+type CycleTimeBucket struct {
+	Key         string
+	WeekStart   time.Time
+	Merged      int
+	CycleTime   Percentiles
+	FirstReview *Percentiles
+}
@@ -1,6 +1,10 @@
 package entity
 
 type Team struct {
-	Members  []TeamMember
-	TeamName string
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+
+	// Version растёт при изменении команды через /team/add, импорт и смену
+	// SLA, отдаётся клиентам как ETag.
+	Version int64 `json:"-"`
 }
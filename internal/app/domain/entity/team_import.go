@@ -0,0 +1,24 @@
+package entity
+
+// TeamImportChangeKind — вид изменения, которое внесёт массовый импорт команд.
+type TeamImportChangeKind string
+
+const (
+	TeamImportCreateTeam TeamImportChangeKind = "create_team"
+	TeamImportCreateUser TeamImportChangeKind = "create_user"
+	TeamImportUpdateUser TeamImportChangeKind = "update_user"
+	TeamImportMoveUser   TeamImportChangeKind = "move_user"
+)
+
+type TeamImportChange struct {
+	Kind     TeamImportChangeKind
+	TeamName string
+	UserID   string
+	// FromTeamName заполняется только для TeamImportMoveUser.
+	FromTeamName string
+}
+
+type TeamImportPlan struct {
+	Applied bool
+	Changes []TeamImportChange
+}
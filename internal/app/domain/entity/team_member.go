@@ -1,9 +1,9 @@
 package entity
 
 type TeamMember struct {
-	IsActive bool
-	UserId   string
-	Username string
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
 }
 
 func (tm TeamMember) ToDomainUser(teamName string) User {
@@ -1,10 +1,13 @@
 package entity
 
 type User struct {
-	IsActive bool
-	TeamName string
-	UserId   string
-	Username string
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+
+	// Version растёт при каждом изменении пользователя, отдаётся клиентам как ETag.
+	Version int64 `json:"-"`
 }
 
 func (u User) ToDomainTeamMember() TeamMember {
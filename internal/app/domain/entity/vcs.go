@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+type VCSProvider string
+
+const (
+	VCSProviderGitHub VCSProvider = "github"
+	VCSProviderGitLab VCSProvider = "gitlab"
+)
+
+type VCSAction string
+
+const (
+	VCSActionOpened   VCSAction = "opened"
+	VCSActionClosed   VCSAction = "closed"
+	VCSActionMerged   VCSAction = "merged"
+	VCSActionReopened VCSAction = "reopened"
+	VCSActionOther    VCSAction = "other"
+)
+
+type VCSSyncOutcome string
+
+const (
+	VCSSyncCreated  VCSSyncOutcome = "created"
+	VCSSyncMerged   VCSSyncOutcome = "merged"
+	VCSSyncClosed   VCSSyncOutcome = "closed"
+	VCSSyncReopened VCSSyncOutcome = "reopened"
+	VCSSyncIgnored  VCSSyncOutcome = "ignored"
+)
+
+// VCSIdentity связывает логин во внешней системе контроля версий с users.id.
+type VCSIdentity struct {
+	Provider  VCSProvider
+	Login     string
+	UserID    string
+	CreatedAt time.Time
+}
+
+// VCSPullRequestEvent — нормализованное событие pull/merge request'а
+// из GitHub или GitLab.
+type VCSPullRequestEvent struct {
+	Provider    VCSProvider
+	Action      VCSAction
+	Repository  string
+	Number      int64
+	Title       string
+	AuthorLogin string
+}
+
+type VCSSyncResult struct {
+	PullRequestID string
+	Outcome       VCSSyncOutcome
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "DEAD"
+)
+
+type WebhookSubscription struct {
+	ID         int64
+	TeamName   string
+	URL        string
+	Secret     string
+	EventTypes []string
+	CreatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	ID              int64
+	SubscriptionID  int64
+	OutboxMessageID int64
+	EventType       string
+	Payload         json.RawMessage
+	Status          WebhookDeliveryStatus
+	Attempts        int
+	NextAttemptAt   time.Time
+	LastError       string
+	ResponseStatus  *int
+	CreatedAt       time.Time
+	DeliveredAt     *time.Time
+}
+
+type WebhookDeliveryFilter struct {
+	SubscriptionID int64
+	Status         WebhookDeliveryStatus
+	Limit          uint64
+}
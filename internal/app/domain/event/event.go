@@ -0,0 +1,237 @@
+// Пакет описывает доменные события, которые сервисы фиксируют в outbox
+// в той же транзакции, что и само изменение.
+package event
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type Type string
+
+const (
+	TypePullRequestCreated  Type = "pull_request.created"
+	TypeReviewerAssigned    Type = "pull_request.reviewer_assigned"
+	TypeReviewerReplaced    Type = "pull_request.reviewer_replaced"
+	TypePullRequestMerged   Type = "pull_request.merged"
+	TypePullRequestClosed   Type = "pull_request.closed"
+	TypePullRequestReopened Type = "pull_request.reopened"
+	TypeReviewReminder      Type = "pull_request.review_reminder"
+	TypeReviewSubmitted     Type = "pull_request.review_submitted"
+	TypeReviewEscalated     Type = "pull_request.review_escalated"
+	TypeUserDeactivated     Type = "user.deactivated"
+)
+
+var knownTypes = []Type{
+	TypePullRequestCreated,
+	TypeReviewerAssigned,
+	TypeReviewerReplaced,
+	TypePullRequestMerged,
+	TypePullRequestClosed,
+	TypePullRequestReopened,
+	TypeReviewReminder,
+	TypeReviewSubmitted,
+	TypeReviewEscalated,
+	TypeUserDeactivated,
+}
+
+func IsKnownType(t string) bool {
+	for _, known := range knownTypes {
+		if string(known) == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Decode восстанавливает событие из сообщения outbox. Возвращает значения тех же
+// типов, что публикуют сервисы, а не указатели.
+func Decode(t string, payload []byte) (Event, error) {
+	switch Type(t) {
+	case TypePullRequestCreated:
+		return decode[PullRequestCreated](t, payload)
+	case TypeReviewerAssigned:
+		return decode[ReviewerAssigned](t, payload)
+	case TypeReviewerReplaced:
+		return decode[ReviewerReplaced](t, payload)
+	case TypePullRequestMerged:
+		return decode[PullRequestMerged](t, payload)
+	case TypePullRequestClosed:
+		return decode[PullRequestClosed](t, payload)
+	case TypePullRequestReopened:
+		return decode[PullRequestReopened](t, payload)
+	case TypeReviewReminder:
+		return decode[ReviewReminder](t, payload)
+	case TypeReviewSubmitted:
+		return decode[ReviewSubmitted](t, payload)
+	case TypeReviewEscalated:
+		return decode[ReviewEscalated](t, payload)
+	case TypeUserDeactivated:
+		return decode[UserDeactivated](t, payload)
+	}
+
+	return nil, fmt.Errorf("unknown event type %q", t)
+}
+
+func decode[E Event](t string, payload []byte) (Event, error) {
+	var e E
+	if err := json.Unmarshal(payload, &e); err != nil {
+		return nil, fmt.Errorf("decode %s: %w", t, err)
+	}
+	return e, nil
+}
+
+type Event interface {
+	EventType() Type
+	// AggregateID — идентификатор сущности, к которой относится событие
+	AggregateID() string
+	// Team — команда, в рамках которой произошло событие
+	Team() string
+	// Users — пользователи, которых затрагивает событие
+	Users() []string
+}
+
+// Publisher доставляет уже зафиксированные события подписчикам внутри процесса.
+type Publisher interface {
+	Publish(events ...Event)
+}
+
+type PullRequestCreated struct {
+	PullRequestID     string    `json:"pull_request_id"`
+	PullRequestName   string    `json:"pull_request_name"`
+	AuthorID          string    `json:"author_id"`
+	TeamName          string    `json:"team_name"`
+	AssignedReviewers []string  `json:"assigned_reviewers"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+func (e PullRequestCreated) EventType() Type     { return TypePullRequestCreated }
+func (e PullRequestCreated) AggregateID() string { return e.PullRequestID }
+func (e PullRequestCreated) Team() string        { return e.TeamName }
+func (e PullRequestCreated) Users() []string {
+	return append([]string{e.AuthorID}, e.AssignedReviewers...)
+}
+
+type ReviewerAssigned struct {
+	PullRequestID string    `json:"pull_request_id"`
+	ReviewerID    string    `json:"reviewer_id"`
+	TeamName      string    `json:"team_name"`
+	AssignedAt    time.Time `json:"assigned_at"`
+}
+
+func (e ReviewerAssigned) EventType() Type     { return TypeReviewerAssigned }
+func (e ReviewerAssigned) AggregateID() string { return e.PullRequestID }
+func (e ReviewerAssigned) Team() string        { return e.TeamName }
+func (e ReviewerAssigned) Users() []string     { return []string{e.ReviewerID} }
+
+type ReviewerReplaced struct {
+	PullRequestID string    `json:"pull_request_id"`
+	OldReviewerID string    `json:"old_reviewer_id"`
+	NewReviewerID string    `json:"new_reviewer_id"`
+	TeamName      string    `json:"team_name"`
+	ReplacedAt    time.Time `json:"replaced_at"`
+}
+
+func (e ReviewerReplaced) EventType() Type     { return TypeReviewerReplaced }
+func (e ReviewerReplaced) AggregateID() string { return e.PullRequestID }
+func (e ReviewerReplaced) Team() string        { return e.TeamName }
+func (e ReviewerReplaced) Users() []string     { return []string{e.OldReviewerID, e.NewReviewerID} }
+
+type PullRequestMerged struct {
+	PullRequestID     string    `json:"pull_request_id"`
+	AuthorID          string    `json:"author_id"`
+	TeamName          string    `json:"team_name"`
+	AssignedReviewers []string  `json:"assigned_reviewers"`
+	MergedAt          time.Time `json:"merged_at"`
+}
+
+func (e PullRequestMerged) EventType() Type     { return TypePullRequestMerged }
+func (e PullRequestMerged) AggregateID() string { return e.PullRequestID }
+func (e PullRequestMerged) Team() string        { return e.TeamName }
+func (e PullRequestMerged) Users() []string {
+	return append([]string{e.AuthorID}, e.AssignedReviewers...)
+}
+
+type PullRequestClosed struct {
+	PullRequestID string    `json:"pull_request_id"`
+	AuthorID      string    `json:"author_id"`
+	TeamName      string    `json:"team_name"`
+	ClosedAt      time.Time `json:"closed_at"`
+}
+
+func (e PullRequestClosed) EventType() Type     { return TypePullRequestClosed }
+func (e PullRequestClosed) AggregateID() string { return e.PullRequestID }
+func (e PullRequestClosed) Team() string        { return e.TeamName }
+func (e PullRequestClosed) Users() []string     { return []string{e.AuthorID} }
+
+type PullRequestReopened struct {
+	PullRequestID     string    `json:"pull_request_id"`
+	AuthorID          string    `json:"author_id"`
+	TeamName          string    `json:"team_name"`
+	AssignedReviewers []string  `json:"assigned_reviewers"`
+	ReopenedAt        time.Time `json:"reopened_at"`
+}
+
+func (e PullRequestReopened) EventType() Type     { return TypePullRequestReopened }
+func (e PullRequestReopened) AggregateID() string { return e.PullRequestID }
+func (e PullRequestReopened) Team() string        { return e.TeamName }
+func (e PullRequestReopened) Users() []string {
+	return append([]string{e.AuthorID}, e.AssignedReviewers...)
+}
+
+// ReviewReminder — ревьювер не отреагировал на PR дольше допустимого срока.
+type ReviewReminder struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	ReviewerID      string    `json:"reviewer_id"`
+	TeamName        string    `json:"team_name"`
+	WaitingSince    time.Time `json:"waiting_since"`
+	RemindedAt      time.Time `json:"reminded_at"`
+}
+
+func (e ReviewReminder) EventType() Type     { return TypeReviewReminder }
+func (e ReviewReminder) AggregateID() string { return e.PullRequestID }
+func (e ReviewReminder) Team() string        { return e.TeamName }
+func (e ReviewReminder) Users() []string     { return []string{e.ReviewerID} }
+
+type ReviewSubmitted struct {
+	PullRequestID string    `json:"pull_request_id"`
+	ReviewerID    string    `json:"reviewer_id"`
+	Decision      string    `json:"decision"`
+	TeamName      string    `json:"team_name"`
+	DecidedAt     time.Time `json:"decided_at"`
+}
+
+func (e ReviewSubmitted) EventType() Type     { return TypeReviewSubmitted }
+func (e ReviewSubmitted) AggregateID() string { return e.PullRequestID }
+func (e ReviewSubmitted) Team() string        { return e.TeamName }
+func (e ReviewSubmitted) Users() []string     { return []string{e.ReviewerID} }
+
+// ReviewEscalated — ревьювер не вынес решение в срок SLA и был заменён
+// автоматически. Сопровождается ReviewerReplaced.
+type ReviewEscalated struct {
+	PullRequestID    string    `json:"pull_request_id"`
+	LapsedReviewerID string    `json:"lapsed_reviewer_id"`
+	NewReviewerID    string    `json:"new_reviewer_id"`
+	TeamName         string    `json:"team_name"`
+	AssignedAt       time.Time `json:"assigned_at"`
+	SLASeconds       int64     `json:"sla_seconds"`
+	EscalatedAt      time.Time `json:"escalated_at"`
+}
+
+func (e ReviewEscalated) EventType() Type     { return TypeReviewEscalated }
+func (e ReviewEscalated) AggregateID() string { return e.PullRequestID }
+func (e ReviewEscalated) Team() string        { return e.TeamName }
+func (e ReviewEscalated) Users() []string     { return []string{e.LapsedReviewerID, e.NewReviewerID} }
+
+type UserDeactivated struct {
+	UserID        string    `json:"user_id"`
+	TeamName      string    `json:"team_name"`
+	DeactivatedAt time.Time `json:"deactivated_at"`
+}
+
+func (e UserDeactivated) EventType() Type     { return TypeUserDeactivated }
+func (e UserDeactivated) AggregateID() string { return e.UserID }
+func (e UserDeactivated) Team() string        { return e.TeamName }
+func (e UserDeactivated) Users() []string     { return []string{e.UserID} }
@@ -0,0 +1,58 @@
+package notifier
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const maxResponseBytes = 4 << 10
+
+// ChatNotifier публикует уведомления во входящий вебхук Slack или Mattermost.
+// Оба принимают JSON вида {"text": "..."}.
+type ChatNotifier struct {
+	httpClient *http.Client
+}
+
+func NewChatNotifier(timeout time.Duration) *ChatNotifier {
+	return &ChatNotifier{
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (c *ChatNotifier) Channel() entity.NotificationChannel { return entity.NotificationChannelChat }
+
+func (c *ChatNotifier) Send(ctx context.Context, n entity.Notification) error {
+	body, err := json.Marshal(struct {
+		Text string `json:"text"`
+	}{
+		Text: fmt.Sprintf("*%s*\n%s", n.Subject, n.Body),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal message: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.Recipient, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBytes))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
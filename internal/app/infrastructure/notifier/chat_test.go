@@ -0,0 +1,53 @@
+package notifier_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/notifier"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestChatNotifier_PostsText(t *testing.T) {
+	received := make(chan map[string]string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("decode payload: %v", err)
+		}
+		received <- payload
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	err := notifier.NewChatNotifier(time.Second).Send(context.Background(), entity.Notification{
+		Channel:   entity.NotificationChannelChat,
+		Recipient: srv.URL,
+		Subject:   "Review requested: pr-1",
+		Body:      "u2 was assigned to review pull request pr-1.",
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	payload := <-received
+	if !strings.Contains(payload["text"], "Review requested: pr-1") || !strings.Contains(payload["text"], "u2 was assigned") {
+		t.Fatalf("unexpected text %q", payload["text"])
+	}
+}
+
+func TestChatNotifier_FailsOnErrorStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	err := notifier.NewChatNotifier(time.Second).Send(context.Background(), entity.Notification{Recipient: srv.URL})
+	if err == nil {
+		t.Fatal("expected error for 404 response")
+	}
+}
@@ -0,0 +1,112 @@
+package notifier_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/notifier"
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+)
+
+type mail struct {
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTP принимает одно соединение и отвечает на минимальный набор команд,
+// которые использует net/smtp без STARTTLS и авторизации.
+func fakeSMTP(t *testing.T) (string, <-chan mail) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	mails := make(chan mail, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		var m mail
+		_ = tp.PrintfLine("220 fake ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+			switch cmd {
+			case "EHLO", "HELO":
+				_ = tp.PrintfLine("250 fake")
+			case "MAIL":
+				m.from = line
+				_ = tp.PrintfLine("250 OK")
+			case "RCPT":
+				m.to = append(m.to, line)
+				_ = tp.PrintfLine("250 OK")
+			case "DATA":
+				_ = tp.PrintfLine("354 go ahead")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				m.data = string(data)
+				_ = tp.PrintfLine("250 OK")
+			case "QUIT":
+				_ = tp.PrintfLine("221 bye")
+				mails <- m
+				return
+			default:
+				_ = tp.PrintfLine("502 not implemented")
+			}
+		}
+	}()
+
+	return ln.Addr().String(), mails
+}
+
+func TestSMTPNotifier_SendsMail(t *testing.T) {
+	addr, mails := fakeSMTP(t)
+	host, port, _ := net.SplitHostPort(addr)
+
+	n := notifier.NewSMTPNotifier(notifier.SMTPConfig{
+		Host:    host,
+		Port:    port,
+		From:    "reviews@example.com",
+		Timeout: time.Second,
+	})
+	err := n.Send(context.Background(), entity.Notification{
+		Channel:   entity.NotificationChannelEmail,
+		Recipient: "bob@example.com",
+		Subject:   "Review requested: pr-1",
+		Body:      "u2 was assigned to review pull request pr-1.",
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	select {
+	case m := <-mails:
+		if !strings.Contains(m.from, "<reviews@example.com>") {
+			t.Fatalf("unexpected MAIL command %q", m.from)
+		}
+		if len(m.to) != 1 || !strings.Contains(m.to[0], "<bob@example.com>") {
+			t.Fatalf("unexpected RCPT commands %v", m.to)
+		}
+		if !strings.Contains(m.data, "Subject: Review requested: pr-1") || !strings.Contains(m.data, "u2 was assigned") {
+			t.Fatalf("unexpected message:\n%s", m.data)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("mail was not received")
+	}
+}
@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) audit.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, event entity.AuditEvent) error {
+	query, args, err := r.sb.
+		Insert("audit_events").
+		Columns("occurred_at", "actor", "action", "entity_type", "entity_id", "before", "after", "request_id").
+		Values(
+			event.OccurredAt,
+			event.Actor,
+			string(event.Action),
+			event.EntityType,
+			event.EntityID,
+			nullableJSON(event.Before),
+			nullableJSON(event.After),
+			event.RequestID,
+		).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) List(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	builder := r.sb.
+		Select("id", "occurred_at", "actor", "action", "entity_type", "entity_id", "before", "after", "request_id").
+		From("audit_events").
+		OrderBy("id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset)
+
+	if filter.Actor != "" {
+		builder = builder.Where(sq.Eq{"actor": filter.Actor})
+	}
+	if filter.Action != "" {
+		builder = builder.Where(sq.Eq{"action": string(filter.Action)})
+	}
+	if filter.EntityType != "" {
+		builder = builder.Where(sq.Eq{"entity_type": filter.EntityType})
+	}
+	if filter.EntityID != "" {
+		builder = builder.Where(sq.Eq{"entity_id": filter.EntityID})
+	}
+	if filter.RequestID != "" {
+		builder = builder.Where(sq.Eq{"request_id": filter.RequestID})
+	}
+	if filter.From != nil {
+		builder = builder.Where(sq.GtOrEq{"occurred_at": *filter.From})
+	}
+	if filter.To != nil {
+		builder = builder.Where(sq.Lt{"occurred_at": *filter.To})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []entity.AuditEvent
+	for rows.Next() {
+		var e entity.AuditEvent
+		var action string
+		var before, after []byte
+		if err := rows.Scan(&e.ID, &e.OccurredAt, &e.Actor, &action, &e.EntityType, &e.EntityID, &before, &after, &e.RequestID); err != nil {
+			return nil, err
+		}
+		e.Action = entity.AuditAction(action)
+		e.Before = before
+		e.After = after
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
+
+func nullableJSON(data json.RawMessage) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return string(data)
+}
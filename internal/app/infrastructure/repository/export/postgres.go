@@ -0,0 +1,111 @@
+package export
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/export"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type source struct {
+	table   string
+	columns []string
+	orderBy []string
+}
+
+// sources описывает, откуда берутся колонки entity.ExportDataset.Columns().
+var sources = map[entity.ExportDataset]source{
+	entity.ExportTeams: {
+		table:   "teams",
+		columns: []string{"team_name", "review_sla_minutes"},
+		orderBy: []string{"team_name"},
+	},
+	entity.ExportUsers: {
+		table:   "users",
+		columns: []string{"id", "username", "team_name", "is_active"},
+		orderBy: []string{"id"},
+	},
+	entity.ExportPullRequests: {
+		table:   "pullrequests",
+		columns: []string{"id", "name", "author_id", "status", "created_at", "merged_at"},
+		orderBy: []string{"created_at", "id"},
+	},
+	entity.ExportAssignments: {
+		table:   "assigned_pr_reviewers",
+		columns: []string{"pr_id", "reviewer_id", "assigned_at", "decision", "decided_at"},
+		orderBy: []string{"assigned_at", "pr_id", "reviewer_id"},
+	},
+	entity.ExportReassignments: {
+		table:   "reviewer_reassignments",
+		columns: []string{"pr_id", "old_reviewer_id", "new_reviewer_id", "assigned_at", "reassigned_at"},
+		orderBy: []string{"id"},
+	},
+}
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) export.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) Stream(ctx context.Context, dataset entity.ExportDataset, fn func(row []any) error) error {
+	src, ok := sources[dataset]
+	if !ok {
+		return fmt.Errorf("unsupported export dataset %q", dataset)
+	}
+
+	query, args, err := r.sb.
+		Select(src.columns...).
+		From(src.table).
+		OrderBy(src.orderBy...).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	values := make([]any, len(src.columns))
+	dest := make([]any, len(src.columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		if err := fn(values); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
@@ -0,0 +1,136 @@
+package idempotency
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/idempotency"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) idempotency.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) Reserve(ctx context.Context, rec entity.IdempotencyRecord, staleBefore time.Time) (bool, error) {
+	// истёкшая или зависшая запись перезаписывается; иначе строка не
+	// возвращается и ключ считается занятым
+	query, args, err := r.sb.
+		Insert("idempotency_keys").
+		Columns("route", "key", "fingerprint", "token", "created_at", "expires_at").
+		Values(rec.Route, rec.Key, rec.Fingerprint, rec.Token, rec.CreatedAt, rec.ExpiresAt).
+		Suffix(`ON CONFLICT (route, key) DO UPDATE SET
+			fingerprint = EXCLUDED.fingerprint,
+			token = EXCLUDED.token,
+			status_code = NULL,
+			content_type = '',
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+			OR (idempotency_keys.status_code IS NULL AND idempotency_keys.created_at <= ?)
+		RETURNING key`, staleBefore).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var key string
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&key); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *PostgresRepository) Get(ctx context.Context, route string, key string) (*entity.IdempotencyRecord, error) {
+	query, args, err := r.sb.
+		Select("route", "key", "fingerprint", "token", "COALESCE(status_code, 0)", "content_type", "response_body", "created_at", "expires_at").
+		From("idempotency_keys").
+		Where(sq.Eq{"route": route, "key": key}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rec entity.IdempotencyRecord
+	err = r.db.QueryRow(ctx, query, args...).Scan(
+		&rec.Route, &rec.Key, &rec.Fingerprint, &rec.Token, &rec.StatusCode, &rec.ContentType, &rec.Body, &rec.CreatedAt, &rec.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &rec, nil
+}
+
+func (r *PostgresRepository) Complete(ctx context.Context, route string, key string, token string, statusCode int, contentType string, body []byte) error {
+	query, args, err := r.sb.
+		Update("idempotency_keys").
+		Set("status_code", statusCode).
+		Set("content_type", contentType).
+		Set("response_body", body).
+		Where(sq.Eq{"route": route, "key": key, "token": token, "status_code": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, route string, key string, token string) error {
+	query, args, err := r.sb.
+		Delete("idempotency_keys").
+		Where(sq.Eq{"route": route, "key": key, "token": token, "status_code": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) DeleteExpired(ctx context.Context, now time.Time) error {
+	query, args, err := r.sb.
+		Delete("idempotency_keys").
+		Where(sq.LtOrEq{"expires_at": now}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
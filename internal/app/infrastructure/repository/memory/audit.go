@@ -0,0 +1,73 @@
+package memory
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+)
+
+type auditRow = entity.AuditEvent
+
+type AuditRepository struct {
+	db db.DB
+}
+
+func NewAuditRepository(d *memdb.DB) *AuditRepository {
+	Define(d)
+	return &AuditRepository{db: d}
+}
+
+func (r *AuditRepository) WithDB(db db.DB) audit.Repository {
+	return &AuditRepository{db: db}
+}
+
+func (r *AuditRepository) Create(ctx context.Context, event entity.AuditEvent) error {
+	return memdb.Write(ctx, r.db, auditTable, func(events *journal[auditRow]) error {
+		event.ID = events.nextID()
+		events.items = append(events.items, event)
+		return nil
+	})
+}
+
+func (r *AuditRepository) List(ctx context.Context, filter entity.AuditFilter) ([]entity.AuditEvent, error) {
+	var found []entity.AuditEvent
+	err := memdb.Read(ctx, r.db, auditTable, func(events *journal[auditRow]) error {
+		skipped := uint64(0)
+		for i := len(events.items) - 1; i >= 0 && uint64(len(found)) < filter.Limit; i-- {
+			e := events.items[i]
+			if !matchAudit(e, filter) {
+				continue
+			}
+			if skipped < filter.Offset {
+				skipped++
+				continue
+			}
+			found = append(found, e)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+func matchAudit(e entity.AuditEvent, filter entity.AuditFilter) bool {
+	switch {
+	case filter.Actor != "" && e.Actor != filter.Actor,
+		filter.Action != "" && e.Action != filter.Action,
+		filter.EntityType != "" && e.EntityType != filter.EntityType,
+		filter.EntityID != "" && e.EntityID != filter.EntityID,
+		filter.RequestID != "" && e.RequestID != filter.RequestID,
+		filter.From != nil && e.OccurredAt.Before(*filter.From),
+		filter.To != nil && !e.OccurredAt.Before(*filter.To):
+		return false
+	}
+	return true
+}
+
+func (r *AuditRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	return beginTx(ctx, r.db)
+}
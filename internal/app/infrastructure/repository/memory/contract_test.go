@@ -0,0 +1,20 @@
+package memory_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/memory"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/repotest"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"testing"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		store := memdb.New()
+		return repotest.Repositories{
+			DB:           store,
+			Users:        memory.NewUserRepository(store),
+			Teams:        memory.NewTeamRepository(store),
+			PullRequests: memory.NewPullRequestRepository(store),
+		}
+	})
+}
@@ -0,0 +1,96 @@
+package memory
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/idempotency"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"maps"
+	"slices"
+	"time"
+)
+
+type idempotencyKey struct {
+	route string
+	key   string
+}
+
+type idempotencyRow = entity.IdempotencyRecord
+
+type IdempotencyRepository struct {
+	db db.DB
+}
+
+func NewIdempotencyRepository(d *memdb.DB) *IdempotencyRepository {
+	Define(d)
+	return &IdempotencyRepository{db: d}
+}
+
+func (r *IdempotencyRepository) WithDB(db db.DB) idempotency.Repository {
+	return &IdempotencyRepository{db: db}
+}
+
+func (r *IdempotencyRepository) Reserve(ctx context.Context, rec entity.IdempotencyRecord, staleBefore time.Time) (bool, error) {
+	var reserved bool
+	err := memdb.Write(ctx, r.db, idempotencyTable, func(t rows[idempotencyKey, idempotencyRow]) error {
+		k := idempotencyKey{route: rec.Route, key: rec.Key}
+		if existing, ok := t[k]; ok {
+			expired := !existing.ExpiresAt.After(rec.CreatedAt)
+			stale := !existing.Completed() && !existing.CreatedAt.After(staleBefore)
+			if !expired && !stale {
+				return nil
+			}
+		}
+		rec.StatusCode, rec.ContentType, rec.Body = 0, "", nil
+		t[k] = rec
+		reserved = true
+		return nil
+	})
+	return reserved, err
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, route string, key string) (*entity.IdempotencyRecord, error) {
+	rec, ok, err := lookup[idempotencyKey, idempotencyRow](ctx, r.db, idempotencyTable, idempotencyKey{route: route, key: key})
+	if err != nil || !ok {
+		return nil, err
+	}
+	rec.Body = slices.Clone(rec.Body)
+	return &rec, nil
+}
+
+func (r *IdempotencyRepository) Complete(ctx context.Context, route string, key string, token string, statusCode int, contentType string, body []byte) error {
+	return memdb.Write(ctx, r.db, idempotencyTable, func(t rows[idempotencyKey, idempotencyRow]) error {
+		k := idempotencyKey{route: route, key: key}
+		rec, ok := t[k]
+		if !ok || rec.Token != token || rec.Completed() {
+			return nil
+		}
+		rec.StatusCode, rec.ContentType, rec.Body = statusCode, contentType, slices.Clone(body)
+		t[k] = rec
+		return nil
+	})
+}
+
+func (r *IdempotencyRepository) Delete(ctx context.Context, route string, key string, token string) error {
+	return memdb.Write(ctx, r.db, idempotencyTable, func(t rows[idempotencyKey, idempotencyRow]) error {
+		k := idempotencyKey{route: route, key: key}
+		if rec, ok := t[k]; ok && rec.Token == token && !rec.Completed() {
+			delete(t, k)
+		}
+		return nil
+	})
+}
+
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) error {
+	return memdb.Write(ctx, r.db, idempotencyTable, func(t rows[idempotencyKey, idempotencyRow]) error {
+		maps.DeleteFunc(t, func(_ idempotencyKey, rec idempotencyRow) bool {
+			return !rec.ExpiresAt.After(now)
+		})
+		return nil
+	})
+}
+
+func (r *IdempotencyRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	return beginTx(ctx, r.db)
+}
@@ -0,0 +1,114 @@
+// Package memory — реализации репозиториев поверх memdb для юнит-тестов и
+// режима STORAGE=memory.
+//
+// Таблицы клонируются поверхностно, поэтому строки в них неизменяемы: запись
+// всегда кладёт в таблицу новое значение, а слайсы внутри строк копируются
+// перед изменением и при выдаче наружу.
+package memory
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const (
+	teamsTable         = "teams"
+	usersTable         = "users"
+	userActivityTable  = "user_activity_log"
+	pullRequestsTable  = "pullrequests"
+	reassignmentsTable = "reviewer_reassignments"
+	auditTable         = "audit_events"
+	outboxTable        = "outbox"
+	idempotencyTable   = "idempotency_keys"
+)
+
+// conflict и foreignKey повторяют ошибки, которые pgxadapter строит из
+// ответов Postgres; имена ограничений — те, что Postgres даёт по умолчанию.
+func conflict(table, constraint, key string) error {
+	return &db.ConstraintError{Kind: db.ErrConflict, Table: table, Constraint: constraint, Err: fmt.Errorf("key %q already exists", key)}
+}
+
+func foreignKey(table, constraint, key string) error {
+	return &db.ConstraintError{Kind: db.ErrForeignKey, Table: table, Constraint: constraint, Err: fmt.Errorf("key %q is not present", key)}
+}
+
+// Define создаёт все таблицы пакета. Конструкторы репозиториев вызывают его
+// сами, так что порядок их создания не важен.
+func Define(d *memdb.DB) {
+	d.Define(teamsTable, rows[string, teamRow]{})
+	d.Define(usersTable, rows[string, userRow]{})
+	d.Define(userActivityTable, &journal[activityRow]{})
+	d.Define(pullRequestsTable, rows[string, pullRequestRow]{})
+	d.Define(reassignmentsTable, &journal[reassignmentRow]{})
+	d.Define(auditTable, &journal[auditRow]{})
+	d.Define(outboxTable, &journal[outboxRow]{})
+	d.Define(idempotencyTable, rows[idempotencyKey, idempotencyRow]{})
+}
+
+// rows — таблица с первичным ключом K.
+type rows[K comparable, V any] map[K]V
+
+func (r rows[K, V]) Clone() memdb.Table {
+	return maps.Clone(r)
+}
+
+// journal — таблица с BIGSERIAL-идентификатором без удалений: строка с
+// идентификатором id лежит в items[id-1].
+type journal[V any] struct {
+	items []V
+}
+
+func (l *journal[V]) Clone() memdb.Table {
+	return &journal[V]{items: slices.Clone(l.items)}
+}
+
+func (l *journal[V]) nextID() int64 {
+	return int64(len(l.items)) + 1
+}
+
+func (l *journal[V]) get(id int64) (V, bool) {
+	if id < 1 || id > int64(len(l.items)) {
+		var zero V
+		return zero, false
+	}
+	return l.items[id-1], true
+}
+
+func beginTx(ctx context.Context, h db.DB) (db.Tx, error) {
+	if transactional, ok := h.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
+
+// lookup читает строку другой таблицы, например для проверки внешнего ключа.
+func lookup[K comparable, V any](ctx context.Context, h db.DB, table string, key K) (V, bool, error) {
+	var (
+		row V
+		ok  bool
+	)
+	err := memdb.Read(ctx, h, table, func(t rows[K, V]) error {
+		row, ok = t[key]
+		return nil
+	})
+	return row, ok, err
+}
+
+// lockVersion читает версию строки под блокировкой писателя — аналог
+// SELECT version ... FOR UPDATE: до конца транзакции строку никто не изменит.
+func lockVersion[V any](ctx context.Context, h db.DB, table string, key string, version func(V) int64) (int64, error) {
+	var v int64
+	err := memdb.Write(ctx, h, table, func(t rows[string, V]) error {
+		row, ok := t[key]
+		if !ok {
+			return fmt.Errorf("%s %q: %w", table, key, db.ErrNotFound)
+		}
+		v = version(row)
+		return nil
+	})
+	return v, err
+}
@@ -0,0 +1,94 @@
+package memory
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"time"
+)
+
+type outboxRow = entity.OutboxMessage
+
+type OutboxRepository struct {
+	db db.DB
+}
+
+func NewOutboxRepository(d *memdb.DB) *OutboxRepository {
+	Define(d)
+	return &OutboxRepository{db: d}
+}
+
+func (r *OutboxRepository) WithDB(db db.DB) outbox.Repository {
+	return &OutboxRepository{db: db}
+}
+
+func (r *OutboxRepository) Add(ctx context.Context, messages ...entity.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return memdb.Write(ctx, r.db, outboxTable, func(box *journal[outboxRow]) error {
+		for _, m := range messages {
+			m.ID = box.nextID()
+			m.Attempts = 0
+			m.DeliveredAt = nil
+			m.LastError = ""
+			box.items = append(box.items, m)
+		}
+		return nil
+	})
+}
+
+// FetchPending не блокирует строки: пишущие транзакции memdb и так
+// выполняются по одной.
+func (r *OutboxRepository) FetchPending(ctx context.Context, now time.Time, limit uint64) ([]entity.OutboxMessage, error) {
+	var pending []entity.OutboxMessage
+	err := memdb.Read(ctx, r.db, outboxTable, func(box *journal[outboxRow]) error {
+		for _, m := range box.items {
+			if uint64(len(pending)) >= limit {
+				break
+			}
+			if m.DeliveredAt == nil && !m.NextAttemptAt.After(now) {
+				pending = append(pending, m)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+func (r *OutboxRepository) MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error {
+	return r.update(ctx, id, func(m *entity.OutboxMessage) {
+		m.DeliveredAt = &deliveredAt
+		m.LastError = ""
+	})
+}
+
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error {
+	return r.update(ctx, id, func(m *entity.OutboxMessage) {
+		m.Attempts = attempts
+		m.NextAttemptAt = nextAttemptAt
+		m.LastError = lastError
+	})
+}
+
+func (r *OutboxRepository) update(ctx context.Context, id int64, fn func(m *entity.OutboxMessage)) error {
+	return memdb.Write(ctx, r.db, outboxTable, func(box *journal[outboxRow]) error {
+		m, ok := box.get(id)
+		if !ok {
+			return nil
+		}
+		fn(&m)
+		box.items[id-1] = m
+		return nil
+	})
+}
+
+func (r *OutboxRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	return beginTx(ctx, r.db)
+}
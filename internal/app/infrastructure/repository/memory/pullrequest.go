@@ -0,0 +1,313 @@
+package memory
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+)
+
+// pullRequestRow хранит PR без AssignedReviewers: они выводятся из reviews.
+// escalationAttempts отображает ревьювера в время неудачной эскалации.
+type pullRequestRow struct {
+	pr                 entity.PullRequest
+	reviews            []entity.ReviewAssignment
+	escalationAttempts map[string]time.Time
+}
+
+func (row pullRequestRow) toEntity() entity.PullRequest {
+	pr := row.pr
+	pr.Reviews = slices.Clone(row.reviews)
+	pr.AssignedReviewers = nil
+	for _, review := range row.reviews {
+		pr.AssignedReviewers = append(pr.AssignedReviewers, review.ReviewerID)
+	}
+	return pr
+}
+
+type reassignmentRow struct {
+	prID          string
+	oldReviewerID string
+	newReviewerID string
+	assignedAt    time.Time
+	reassignedAt  time.Time
+}
+
+type PullRequestRepository struct {
+	db db.DB
+}
+
+func NewPullRequestRepository(d *memdb.DB) *PullRequestRepository {
+	Define(d)
+	return &PullRequestRepository{db: d}
+}
+
+func (r *PullRequestRepository) WithDB(db db.DB) pullrequest.Repository {
+	return &PullRequestRepository{db: db}
+}
+
+func (r *PullRequestRepository) Create(ctx context.Context, pr entity.PullRequest) error {
+	if err := r.checkUser(ctx, pr.AuthorId, pullRequestsTable, "pullrequests_author_id_fkey"); err != nil {
+		return err
+	}
+
+	assignedAt := time.Now().UTC()
+	if pr.CreatedAt != nil {
+		assignedAt = *pr.CreatedAt
+	}
+	reviews := make([]entity.ReviewAssignment, 0, len(pr.AssignedReviewers))
+	for _, reviewer := range pr.AssignedReviewers {
+		if err := r.checkUser(ctx, reviewer, "assigned_pr_reviewers", "assigned_pr_reviewers_reviewer_id_fkey"); err != nil {
+			return err
+		}
+		if slices.ContainsFunc(reviews, func(a entity.ReviewAssignment) bool { return a.ReviewerID == reviewer }) {
+			return conflict("assigned_pr_reviewers", "assigned_pr_reviewers_pkey", reviewer)
+		}
+		reviews = append(reviews, entity.ReviewAssignment{ReviewerID: reviewer, AssignedAt: assignedAt})
+	}
+	sortReviews(reviews)
+
+	row := pr
+	row.AssignedReviewers = nil
+	row.Reviews = nil
+	row.SLAStatus = ""
+	row.Version = 1
+
+	return memdb.Write(ctx, r.db, pullRequestsTable, func(prs rows[string, pullRequestRow]) error {
+		if _, ok := prs[pr.PullRequestId]; ok {
+			return conflict(pullRequestsTable, "pullrequests_pkey", pr.PullRequestId)
+		}
+		prs[pr.PullRequestId] = pullRequestRow{pr: row, reviews: reviews}
+		return nil
+	})
+}
+
+// checkUser проверяет внешний ключ table.constraint на users.
+func (r *PullRequestRepository) checkUser(ctx context.Context, userID string, table, constraint string) error {
+	_, ok, err := lookup[string, userRow](ctx, r.db, usersTable, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return foreignKey(table, constraint, userID)
+	}
+	return nil
+}
+
+func (r *PullRequestRepository) GetByID(ctx context.Context, id string) (*entity.PullRequest, error) {
+	row, ok, err := lookup[string, pullRequestRow](ctx, r.db, pullRequestsTable, id)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	pr := row.toEntity()
+	return &pr, nil
+}
+
+func (r *PullRequestRepository) LockVersion(ctx context.Context, prID string) (int64, error) {
+	return lockVersion(ctx, r.db, pullRequestsTable, prID, func(row pullRequestRow) int64 { return row.pr.Version })
+}
+
+func (r *PullRequestRepository) UpdateStatus(ctx context.Context, prID string, status entity.PRStatus, mergedAt *time.Time) error {
+	return r.update(ctx, prID, func(row *pullRequestRow) error {
+		row.pr.Status = status
+		row.pr.MergedAt = mergedAt
+		return nil
+	})
+}
+
+func (r *PullRequestRepository) ReplaceReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, assignedAt time.Time) error {
+	if err := r.checkUser(ctx, newReviewerID, reassignmentsTable, "reviewer_reassignments_new_reviewer_id_fkey"); err != nil {
+		return err
+	}
+
+	var oldAssignedAt time.Time
+	err := r.update(ctx, prID, func(row *pullRequestRow) error {
+		i := slices.IndexFunc(row.reviews, func(a entity.ReviewAssignment) bool { return a.ReviewerID == oldReviewerID })
+		if i < 0 {
+			return fmt.Errorf("reviewer %q of %q: %w", oldReviewerID, prID, db.ErrNotFound)
+		}
+		if slices.ContainsFunc(row.reviews, func(a entity.ReviewAssignment) bool { return a.ReviewerID == newReviewerID }) {
+			return conflict("assigned_pr_reviewers", "assigned_pr_reviewers_pkey", newReviewerID)
+		}
+
+		oldAssignedAt = row.reviews[i].AssignedAt
+		row.reviews = slices.Delete(row.reviews, i, i+1)
+		delete(row.escalationAttempts, oldReviewerID)
+		row.reviews = append(row.reviews, entity.ReviewAssignment{ReviewerID: newReviewerID, AssignedAt: assignedAt})
+		sortReviews(row.reviews)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// история нужна статистике: после замены назначение удалено
+	return memdb.Write(ctx, r.db, reassignmentsTable, func(history *journal[reassignmentRow]) error {
+		history.items = append(history.items, reassignmentRow{
+			prID:          prID,
+			oldReviewerID: oldReviewerID,
+			newReviewerID: newReviewerID,
+			assignedAt:    oldAssignedAt,
+			reassignedAt:  assignedAt,
+		})
+		return nil
+	})
+}
+
+func (r *PullRequestRepository) SetDecision(ctx context.Context, prID string, reviewerID string, decision entity.ReviewDecision, decidedAt time.Time) error {
+	return r.update(ctx, prID, func(row *pullRequestRow) error {
+		for i := range row.reviews {
+			if row.reviews[i].ReviewerID == reviewerID {
+				row.reviews[i].Decision = decision
+				row.reviews[i].DecidedAt = &decidedAt
+			}
+		}
+		return nil
+	})
+}
+
+// update применяет fn к копии строки PR и увеличивает её версию; отсутствующий
+// PR, как UPDATE без совпадений, не считается ошибкой.
+func (r *PullRequestRepository) update(ctx context.Context, prID string, fn func(row *pullRequestRow) error) error {
+	return memdb.Write(ctx, r.db, pullRequestsTable, func(prs rows[string, pullRequestRow]) error {
+		row, ok := prs[prID]
+		if !ok {
+			return nil
+		}
+		row.reviews = slices.Clone(row.reviews)
+		row.escalationAttempts = maps.Clone(row.escalationAttempts)
+		if err := fn(&row); err != nil {
+			return err
+		}
+		row.pr.Version++
+		prs[prID] = row
+		return nil
+	})
+}
+
+func (r *PullRequestRepository) ListOverdue(ctx context.Context, now time.Time, defaultSLA time.Duration, limit uint64) ([]entity.OverdueReview, error) {
+	type candidate struct {
+		review    entity.OverdueReview
+		attempted time.Time
+	}
+	var overdue []candidate
+	err := memdb.Read(ctx, r.db, pullRequestsTable, func(prs rows[string, pullRequestRow]) error {
+		for _, row := range prs {
+			if row.pr.Status != entity.PullRequestStatusOPEN {
+				continue
+			}
+
+			author, ok, err := lookup[string, userRow](ctx, r.db, usersTable, row.pr.AuthorId)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			team, ok, err := lookup[string, teamRow](ctx, r.db, teamsTable, author.TeamName)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+			sla := defaultSLA
+			if team.reviewSLA != nil {
+				sla = *team.reviewSLA
+			}
+
+			for _, review := range row.reviews {
+				if review.Decision == "" && !review.AssignedAt.Add(sla).After(now) {
+					overdue = append(overdue, candidate{
+						review: entity.OverdueReview{
+							PullRequestID: row.pr.PullRequestId,
+							ReviewerID:    review.ReviewerID,
+							TeamName:      author.TeamName,
+							AssignedAt:    review.AssignedAt,
+						},
+						attempted: row.escalationAttempts[review.ReviewerID],
+					})
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// нулевое время без попыток, как NULLS FIRST, идёт первым
+	slices.SortFunc(overdue, func(a, b candidate) int {
+		return cmp.Or(
+			a.attempted.Compare(b.attempted),
+			a.review.AssignedAt.Compare(b.review.AssignedAt),
+			cmp.Compare(a.review.PullRequestID, b.review.PullRequestID),
+			cmp.Compare(a.review.ReviewerID, b.review.ReviewerID),
+		)
+	})
+	if uint64(len(overdue)) > limit {
+		overdue = overdue[:limit]
+	}
+
+	var reviews []entity.OverdueReview
+	for _, c := range overdue {
+		reviews = append(reviews, c.review)
+	}
+	return reviews, nil
+}
+
+func (r *PullRequestRepository) MarkEscalationAttempted(ctx context.Context, prID string, reviewerID string, at time.Time) error {
+	return memdb.Write(ctx, r.db, pullRequestsTable, func(prs rows[string, pullRequestRow]) error {
+		row, ok := prs[prID]
+		if !ok || !slices.ContainsFunc(row.reviews, func(a entity.ReviewAssignment) bool { return a.ReviewerID == reviewerID }) {
+			return nil
+		}
+		row.escalationAttempts = maps.Clone(row.escalationAttempts)
+		if row.escalationAttempts == nil {
+			row.escalationAttempts = make(map[string]time.Time)
+		}
+		row.escalationAttempts[reviewerID] = at
+		prs[prID] = row
+		return nil
+	})
+}
+
+func (r *PullRequestRepository) GetByAssignedReviewer(ctx context.Context, userID string) ([]entity.PullRequest, error) {
+	var found []entity.PullRequest
+	err := memdb.Read(ctx, r.db, pullRequestsTable, func(prs rows[string, pullRequestRow]) error {
+		for _, row := range prs {
+			i := slices.IndexFunc(row.reviews, func(a entity.ReviewAssignment) bool { return a.ReviewerID == userID })
+			if i < 0 {
+				continue
+			}
+			pr := row.pr
+			// только назначение запрошенного ревьювера, как в Postgres-реализации
+			pr.Reviews = []entity.ReviewAssignment{row.reviews[i]}
+			found = append(found, pr)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(found, func(a, b entity.PullRequest) int { return cmp.Compare(a.PullRequestId, b.PullRequestId) })
+	return found, nil
+}
+
+func sortReviews(reviews []entity.ReviewAssignment) {
+	slices.SortFunc(reviews, func(a, b entity.ReviewAssignment) int {
+		return cmp.Or(a.AssignedAt.Compare(b.AssignedAt), cmp.Compare(a.ReviewerID, b.ReviewerID))
+	})
+}
+
+func (r *PullRequestRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	return beginTx(ctx, r.db)
+}
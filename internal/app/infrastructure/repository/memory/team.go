@@ -0,0 +1,113 @@
+package memory
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+)
+
+type teamRow struct {
+	reviewSLA *time.Duration
+	version   int64
+}
+
+type TeamRepository struct {
+	db db.DB
+}
+
+func NewTeamRepository(d *memdb.DB) *TeamRepository {
+	Define(d)
+	return &TeamRepository{db: d}
+}
+
+func (r *TeamRepository) WithDB(db db.DB) team.Repository {
+	return &TeamRepository{db: db}
+}
+
+func (r *TeamRepository) Create(ctx context.Context, teamName string) error {
+	return memdb.Write(ctx, r.db, teamsTable, func(teams rows[string, teamRow]) error {
+		if _, ok := teams[teamName]; ok {
+			return conflict(teamsTable, "teams_pkey", teamName)
+		}
+		teams[teamName] = teamRow{version: 1}
+		return nil
+	})
+}
+
+func (r *TeamRepository) Exists(ctx context.Context, teamName string) (bool, error) {
+	_, ok, err := lookup[string, teamRow](ctx, r.db, teamsTable, teamName)
+	return ok, err
+}
+
+func (r *TeamRepository) List(ctx context.Context) ([]string, error) {
+	var names []string
+	err := memdb.Read(ctx, r.db, teamsTable, func(teams rows[string, teamRow]) error {
+		for name := range teams {
+			names = append(names, name)
+		}
+		return nil
+	})
+	slices.Sort(names)
+	return names, err
+}
+
+func (r *TeamRepository) GetReviewSLA(ctx context.Context, teamName string) (*time.Duration, error) {
+	row, _, err := lookup[string, teamRow](ctx, r.db, teamsTable, teamName)
+	if err != nil || row.reviewSLA == nil {
+		return nil, err
+	}
+
+	sla := *row.reviewSLA
+	return &sla, nil
+}
+
+func (r *TeamRepository) SetReviewSLA(ctx context.Context, teamName string, sla time.Duration) error {
+	return memdb.Write(ctx, r.db, teamsTable, func(teams rows[string, teamRow]) error {
+		row, ok := teams[teamName]
+		if !ok {
+			return nil
+		}
+		// в Postgres SLA хранится в минутах
+		minutes := sla.Truncate(time.Minute)
+		teams[teamName] = teamRow{reviewSLA: &minutes, version: row.version + 1}
+		return nil
+	})
+}
+
+func (r *TeamRepository) Version(ctx context.Context, teamName string) (int64, error) {
+	row, ok, err := lookup[string, teamRow](ctx, r.db, teamsTable, teamName)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, fmt.Errorf("%s %q: %w", teamsTable, teamName, db.ErrNotFound)
+	}
+	return row.version, nil
+}
+
+func (r *TeamRepository) LockVersion(ctx context.Context, teamName string) (int64, error) {
+	return lockVersion(ctx, r.db, teamsTable, teamName, func(row teamRow) int64 { return row.version })
+}
+
+func (r *TeamRepository) BumpVersion(ctx context.Context, teamName string) (int64, error) {
+	var version int64
+	err := memdb.Write(ctx, r.db, teamsTable, func(teams rows[string, teamRow]) error {
+		row, ok := teams[teamName]
+		if !ok {
+			return fmt.Errorf("%s %q: %w", teamsTable, teamName, db.ErrNotFound)
+		}
+		row.version++
+		teams[teamName] = row
+		version = row.version
+		return nil
+	})
+	return version, err
+}
+
+func (r *TeamRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	return beginTx(ctx, r.db)
+}
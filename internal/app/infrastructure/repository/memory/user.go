@@ -0,0 +1,130 @@
+package memory
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+)
+
+type userRow = entity.User
+
+type activityRow struct {
+	userID    string
+	isActive  bool
+	changedAt time.Time
+}
+
+type UserRepository struct {
+	db db.DB
+}
+
+func NewUserRepository(d *memdb.DB) *UserRepository {
+	Define(d)
+	return &UserRepository{db: d}
+}
+
+func (r *UserRepository) WithDB(db db.DB) user.Repository {
+	return &UserRepository{db: db}
+}
+
+func (r *UserRepository) Create(ctx context.Context, u entity.User) error {
+	if err := r.checkTeam(ctx, u.TeamName); err != nil {
+		return err
+	}
+
+	return memdb.Write(ctx, r.db, usersTable, func(users rows[string, userRow]) error {
+		if _, ok := users[u.UserId]; ok {
+			return conflict(usersTable, "users_pkey", u.UserId)
+		}
+		u.Version = 1
+		users[u.UserId] = u
+		return nil
+	})
+}
+
+func (r *UserRepository) Update(ctx context.Context, u entity.User) error {
+	if err := r.checkTeam(ctx, u.TeamName); err != nil {
+		return err
+	}
+
+	return memdb.Write(ctx, r.db, usersTable, func(users rows[string, userRow]) error {
+		if existing, ok := users[u.UserId]; ok {
+			u.Version = existing.Version + 1
+			users[u.UserId] = u
+		}
+		return nil
+	})
+}
+
+func (r *UserRepository) checkTeam(ctx context.Context, teamName string) error {
+	_, ok, err := lookup[string, teamRow](ctx, r.db, teamsTable, teamName)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return foreignKey(usersTable, "users_team_name_fkey", teamName)
+	}
+	return nil
+}
+
+func (r *UserRepository) LockVersion(ctx context.Context, userID string) (int64, error) {
+	return lockVersion(ctx, r.db, usersTable, userID, func(u userRow) int64 { return u.Version })
+}
+
+func (r *UserRepository) RecordActivity(ctx context.Context, userID string, isActive bool, at time.Time) error {
+	_, ok, err := lookup[string, userRow](ctx, r.db, usersTable, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return foreignKey(userActivityTable, "user_activity_log_user_id_fkey", userID)
+	}
+
+	return memdb.Write(ctx, r.db, userActivityTable, func(activity *journal[activityRow]) error {
+		activity.items = append(activity.items, activityRow{userID: userID, isActive: isActive, changedAt: at})
+		return nil
+	})
+}
+
+func (r *UserRepository) GetByID(ctx context.Context, userID string) (*entity.User, error) {
+	u, ok, err := lookup[string, userRow](ctx, r.db, usersTable, userID)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *UserRepository) GetByTeam(ctx context.Context, teamName string) ([]entity.User, error) {
+	return r.selectUsers(ctx, func(u entity.User) bool { return u.TeamName == teamName })
+}
+
+func (r *UserRepository) List(ctx context.Context) ([]entity.User, error) {
+	return r.selectUsers(ctx, func(entity.User) bool { return true })
+}
+
+func (r *UserRepository) selectUsers(ctx context.Context, match func(entity.User) bool) ([]entity.User, error) {
+	var found []entity.User
+	err := memdb.Read(ctx, r.db, usersTable, func(users rows[string, userRow]) error {
+		for _, u := range users {
+			if match(u) {
+				found = append(found, u)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(found, func(a, b entity.User) int { return cmp.Compare(a.UserId, b.UserId) })
+	return found, nil
+}
+
+func (r *UserRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	return beginTx(ctx, r.db)
+}
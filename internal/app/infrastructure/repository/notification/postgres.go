@@ -0,0 +1,267 @@
+package notification
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/notification"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) notification.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) GetTeamSettings(ctx context.Context, teamName string) (*entity.TeamNotificationSettings, error) {
+	query, args, err := r.sb.
+		Select("team_name", "chat_webhook_url", "mode", "updated_at").
+		From("team_notification_settings").
+		Where(sq.Eq{"team_name": teamName}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var settings entity.TeamNotificationSettings
+	var mode string
+	row := r.db.QueryRow(ctx, query, args...)
+	if err := row.Scan(&settings.TeamName, &settings.ChatWebhookURL, &mode, &settings.UpdatedAt); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	settings.Mode = entity.NotificationMode(mode)
+
+	return &settings, nil
+}
+
+func (r *PostgresRepository) UpsertTeamSettings(ctx context.Context, settings entity.TeamNotificationSettings) error {
+	query, args, err := r.sb.
+		Insert("team_notification_settings").
+		Columns("team_name", "chat_webhook_url", "mode", "updated_at").
+		Values(settings.TeamName, settings.ChatWebhookURL, string(settings.Mode), settings.UpdatedAt).
+		Suffix("ON CONFLICT (team_name) DO UPDATE SET " +
+			"chat_webhook_url = EXCLUDED.chat_webhook_url, mode = EXCLUDED.mode, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) GetUserPreferences(ctx context.Context, userID string) (*entity.UserNotificationPreferences, error) {
+	query, args, err := r.sb.
+		Select("user_id", "email", "mode", "updated_at").
+		From("user_notification_preferences").
+		Where(sq.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var prefs entity.UserNotificationPreferences
+	var mode string
+	row := r.db.QueryRow(ctx, query, args...)
+	if err := row.Scan(&prefs.UserID, &prefs.Email, &mode, &prefs.UpdatedAt); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	prefs.Mode = entity.NotificationMode(mode)
+
+	return &prefs, nil
+}
+
+func (r *PostgresRepository) UpsertUserPreferences(ctx context.Context, prefs entity.UserNotificationPreferences) error {
+	query, args, err := r.sb.
+		Insert("user_notification_preferences").
+		Columns("user_id", "email", "mode", "updated_at").
+		Values(prefs.UserID, prefs.Email, string(prefs.Mode), prefs.UpdatedAt).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET " +
+			"email = EXCLUDED.email, mode = EXCLUDED.mode, updated_at = EXCLUDED.updated_at").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) Enqueue(ctx context.Context, n entity.Notification) (entity.Notification, error) {
+	// DO UPDATE без изменения данных нужен, чтобы RETURNING вернул и уже существующую строку
+	query, args, err := r.sb.
+		Insert("notifications").
+		Columns("outbox_message_id", "channel", "recipient", "mode", "subject", "body", "created_at").
+		Values(n.OutboxMessageID, string(n.Channel), n.Recipient, string(n.Mode), n.Subject, n.Body, n.CreatedAt).
+		Suffix("ON CONFLICT (outbox_message_id, channel, recipient) DO UPDATE SET channel = EXCLUDED.channel " +
+			"RETURNING id, mode, subject, body, created_at, sent_at").
+		ToSql()
+	if err != nil {
+		return entity.Notification{}, err
+	}
+
+	var mode string
+	row := r.db.QueryRow(ctx, query, args...)
+	if err := row.Scan(&n.ID, &mode, &n.Subject, &n.Body, &n.CreatedAt, &n.SentAt); err != nil {
+		return entity.Notification{}, err
+	}
+	n.Mode = entity.NotificationMode(mode)
+
+	return n, nil
+}
+
+func (r *PostgresRepository) MarkSent(ctx context.Context, id int64, sentAt time.Time) error {
+	query, args, err := r.sb.
+		Update("notifications").
+		Set("sent_at", sentAt).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) ListDigestRecipients(ctx context.Context) ([]entity.UserNotificationPreferences, error) {
+	query, args, err := r.sb.
+		Select("p.user_id", "p.email", "p.mode", "p.updated_at").
+		From("user_notification_preferences p").
+		Join("users u ON u.id = p.user_id").
+		LeftJoin("team_notification_settings t ON t.team_name = u.team_name").
+		Where(sq.NotEq{"p.email": ""}).
+		Where(sq.NotEq{"p.mode": string(entity.NotificationModeOff)}).
+		Where(sq.Expr("COALESCE(t.mode, ?) <> ?", string(notification.DefaultMode), string(entity.NotificationModeOff))).
+		Where(sq.Eq{"u.is_active": true}).
+		OrderBy("p.user_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entity.UserNotificationPreferences
+	for rows.Next() {
+		var prefs entity.UserNotificationPreferences
+		var mode string
+		if err := rows.Scan(&prefs.UserID, &prefs.Email, &mode, &prefs.UpdatedAt); err != nil {
+			return nil, err
+		}
+		prefs.Mode = entity.NotificationMode(mode)
+		result = append(result, prefs)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PostgresRepository) ListPendingDigestRecipients(ctx context.Context, channel entity.NotificationChannel) ([]string, error) {
+	query, args, err := r.sb.
+		Select("DISTINCT recipient").
+		From("notifications").
+		Where(sq.Eq{"channel": string(channel), "mode": string(entity.NotificationModeDigest), "sent_at": nil}).
+		OrderBy("recipient").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recipients []string
+	for rows.Next() {
+		var recipient string
+		if err := rows.Scan(&recipient); err != nil {
+			return nil, err
+		}
+		recipients = append(recipients, recipient)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return recipients, nil
+}
+
+func (r *PostgresRepository) ListPendingDigest(ctx context.Context, channel entity.NotificationChannel, recipient string) ([]entity.Notification, error) {
+	query, args, err := r.sb.
+		Select("id", "outbox_message_id", "channel", "recipient", "mode", "subject", "body", "created_at", "sent_at").
+		From("notifications").
+		Where(sq.Eq{
+			"channel":   string(channel),
+			"recipient": recipient,
+			"mode":      string(entity.NotificationModeDigest),
+			"sent_at":   nil,
+		}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entity.Notification
+	for rows.Next() {
+		var n entity.Notification
+		var ch, mode string
+		if err := rows.Scan(&n.ID, &n.OutboxMessageID, &ch, &n.Recipient, &mode, &n.Subject, &n.Body, &n.CreatedAt, &n.SentAt); err != nil {
+			return nil, err
+		}
+		n.Channel = entity.NotificationChannel(ch)
+		n.Mode = entity.NotificationMode(mode)
+		result = append(result, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
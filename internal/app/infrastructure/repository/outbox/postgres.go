@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/outbox"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) outbox.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) Add(ctx context.Context, messages ...entity.OutboxMessage) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	builder := r.sb.
+		Insert("outbox").
+		Columns("event_type", "aggregate_id", "team_name", "payload", "created_at", "next_attempt_at")
+	for _, m := range messages {
+		builder = builder.Values(m.EventType, m.AggregateID, m.TeamName, string(m.Payload), m.CreatedAt, m.NextAttemptAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) FetchPending(ctx context.Context, now time.Time, limit uint64) ([]entity.OutboxMessage, error) {
+	query, args, err := r.sb.
+		Select("id", "event_type", "aggregate_id", "team_name", "payload", "created_at", "attempts", "next_attempt_at").
+		From("outbox").
+		Where(sq.Eq{"delivered_at": nil}).
+		Where(sq.LtOrEq{"next_attempt_at": now}).
+		OrderBy("id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var messages []entity.OutboxMessage
+	for rows.Next() {
+		var m entity.OutboxMessage
+		var payload []byte
+		if err := rows.Scan(&m.ID, &m.EventType, &m.AggregateID, &m.TeamName, &payload, &m.CreatedAt, &m.Attempts, &m.NextAttemptAt); err != nil {
+			return nil, err
+		}
+		m.Payload = payload
+		messages = append(messages, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (r *PostgresRepository) MarkDelivered(ctx context.Context, id int64, deliveredAt time.Time) error {
+	query, args, err := r.sb.
+		Update("outbox").
+		Set("delivered_at", deliveredAt).
+		Set("last_error", "").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) MarkFailed(ctx context.Context, id int64, attempts int, nextAttemptAt time.Time, lastError string) error {
+	query, args, err := r.sb.
+		Update("outbox").
+		Set("attempts", attempts).
+		Set("next_attempt_at", nextAttemptAt).
+		Set("last_error", lastError).
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
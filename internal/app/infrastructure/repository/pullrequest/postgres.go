@@ -5,6 +5,7 @@ import (
 	"avito-backend-intern-assignment/internal/app/domain/entity"
 	"avito-backend-intern-assignment/pkg/db"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -44,26 +45,32 @@ func (r *PostgresRepository) Create(ctx context.Context, pr entity.PullRequest)
 		return err
 	}
 
+	if len(pr.AssignedReviewers) == 0 {
+		return nil
+	}
+
+	assignedAt := time.Now().UTC()
+	if pr.CreatedAt != nil {
+		assignedAt = *pr.CreatedAt
+	}
+	// все ревьюверы одной вставкой: PR не может остаться с частью назначений
+	insert := r.sb.
+		Insert("assigned_pr_reviewers").
+		Columns("pr_id", "reviewer_id", "assigned_at")
 	for _, reviewer := range pr.AssignedReviewers {
-		query, args, err := r.sb.
-			Insert("assigned_pr_reviewers").
-			Columns("pr_id", "reviewer_id").
-			Values(pr.PullRequestId, reviewer).
-			ToSql()
-		if err != nil {
-			return err
-		}
-		if err := r.db.Exec(ctx, query, args...); err != nil {
-			return err
-		}
+		insert = insert.Values(pr.PullRequestId, reviewer, assignedAt)
+	}
+	query, args, err = insert.ToSql()
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return r.db.Exec(ctx, query, args...)
 }
 
 func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*entity.PullRequest, error) {
 	query, args, err := r.sb.
-		Select("id", "name", "author_id", "status", "created_at", "merged_at").
+		Select("id", "name", "author_id", "status", "created_at", "merged_at", "version").
 		From("pullrequests").
 		Where(sq.Eq{"id": id}).
 		ToSql()
@@ -75,8 +82,8 @@ func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*entity.Pu
 
 	var pr entity.PullRequest
 	var status string
-	if err := row.Scan(&pr.PullRequestId, &pr.PullRequestName, &pr.AuthorId, &status, &pr.CreatedAt, &pr.MergedAt); err != nil {
-		if err.Error() == "no rows in result set" {
+	if err := row.Scan(&pr.PullRequestId, &pr.PullRequestName, &pr.AuthorId, &status, &pr.CreatedAt, &pr.MergedAt, &pr.Version); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
 			return nil, nil
 		}
 		return nil, err
@@ -84,9 +91,10 @@ func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*entity.Pu
 	pr.Status = entity.PRStatus(status)
 
 	rowsQuery, rowsArgs, err := r.sb.
-		Select("reviewer_id").
+		Select("reviewer_id", "assigned_at", "decision", "decided_at").
 		From("assigned_pr_reviewers").
 		Where(sq.Eq{"pr_id": pr.PullRequestId}).
+		OrderBy("assigned_at", "reviewer_id").
 		ToSql()
 	if err != nil {
 		return nil, err
@@ -99,18 +107,21 @@ func (r *PostgresRepository) GetByID(ctx context.Context, id string) (*entity.Pu
 	defer rows.Close()
 
 	var reviewers []string
+	var reviews []entity.ReviewAssignment
 	for rows.Next() {
-		var rID string
-		if err := rows.Scan(&rID); err != nil {
+		review, err := scanReview(rows)
+		if err != nil {
 			return nil, err
 		}
-		reviewers = append(reviewers, rID)
+		reviewers = append(reviewers, review.ReviewerID)
+		reviews = append(reviews, review)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
 	pr.AssignedReviewers = reviewers
+	pr.Reviews = reviews
 	return &pr, nil
 }
 
@@ -119,6 +130,7 @@ func (r *PostgresRepository) UpdateStatus(ctx context.Context, prID string, stat
 		Update("pullrequests").
 		Set("status", string(status)).
 		Set("merged_at", mergedAt).
+		Set("version", sq.Expr("version + 1")).
 		Where(sq.Eq{"id": prID}).
 		ToSql()
 	if err != nil {
@@ -128,26 +140,150 @@ func (r *PostgresRepository) UpdateStatus(ctx context.Context, prID string, stat
 	return r.db.Exec(ctx, query, args...)
 }
 
-func (r *PostgresRepository) ReplaceReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) error {
+func (r *PostgresRepository) ReplaceReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, assignedAt time.Time) error {
 	delQuery, delArgs, _ := r.sb.
 		Delete("assigned_pr_reviewers").
 		Where(sq.Eq{"pr_id": prID, "reviewer_id": oldReviewerID}).
+		Suffix("RETURNING assigned_at").
 		ToSql()
-	if err := r.db.Exec(ctx, delQuery, delArgs...); err != nil {
+	var oldAssignedAt time.Time
+	if err := r.db.QueryRow(ctx, delQuery, delArgs...).Scan(&oldAssignedAt); err != nil {
+		return err
+	}
+
+	// история нужна статистике: после замены строка назначения удалена
+	histQuery, histArgs, _ := r.sb.
+		Insert("reviewer_reassignments").
+		Columns("pr_id", "old_reviewer_id", "new_reviewer_id", "assigned_at", "reassigned_at").
+		Values(prID, oldReviewerID, newReviewerID, oldAssignedAt, assignedAt).
+		ToSql()
+	if err := r.db.Exec(ctx, histQuery, histArgs...); err != nil {
 		return err
 	}
 
 	insQuery, insArgs, _ := r.sb.
 		Insert("assigned_pr_reviewers").
-		Columns("pr_id", "reviewer_id").
-		Values(prID, newReviewerID).
+		Columns("pr_id", "reviewer_id", "assigned_at").
+		Values(prID, newReviewerID, assignedAt).
 		ToSql()
-	return r.db.Exec(ctx, insQuery, insArgs...)
+	if err := r.db.Exec(ctx, insQuery, insArgs...); err != nil {
+		return err
+	}
+
+	return r.bumpVersion(ctx, prID)
+}
+
+func (r *PostgresRepository) SetDecision(ctx context.Context, prID string, reviewerID string, decision entity.ReviewDecision, decidedAt time.Time) error {
+	query, args, err := r.sb.
+		Update("assigned_pr_reviewers").
+		Set("decision", string(decision)).
+		Set("decided_at", decidedAt).
+		Where(sq.Eq{"pr_id": prID, "reviewer_id": reviewerID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if err := r.db.Exec(ctx, query, args...); err != nil {
+		return err
+	}
+
+	return r.bumpVersion(ctx, prID)
+}
+
+// bumpVersion отмечает изменение PR, сделанное в таблице назначений.
+func (r *PostgresRepository) bumpVersion(ctx context.Context, prID string) error {
+	query, args, err := r.sb.
+		Update("pullrequests").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"id": prID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) LockVersion(ctx context.Context, prID string) (int64, error) {
+	query, args, err := r.sb.
+		Select("version").
+		From("pullrequests").
+		Where(sq.Eq{"id": prID}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (r *PostgresRepository) ListOverdue(ctx context.Context, now time.Time, defaultSLA time.Duration, limit uint64) ([]entity.OverdueReview, error) {
+	query, args, err := r.sb.
+		Select("apr.pr_id", "apr.reviewer_id", "u.team_name", "apr.assigned_at").
+		From("assigned_pr_reviewers apr").
+		Join("pullrequests pr ON pr.id = apr.pr_id").
+		Join("users u ON u.id = pr.author_id").
+		Join("teams t ON t.team_name = u.team_name").
+		Where(sq.Eq{"pr.status": string(entity.PullRequestStatusOPEN)}).
+		Where(sq.Eq{"apr.decision": nil}).
+		Where(sq.Expr(
+			"apr.assigned_at + COALESCE(t.review_sla_minutes * interval '1 minute', ? * interval '1 second') <= ?",
+			int64(defaultSLA/time.Second), now,
+		)).
+		OrderBy("apr.escalation_attempted_at NULLS FIRST", "apr.assigned_at", "apr.pr_id", "apr.reviewer_id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var overdue []entity.OverdueReview
+	for rows.Next() {
+		var o entity.OverdueReview
+		if err := rows.Scan(&o.PullRequestID, &o.ReviewerID, &o.TeamName, &o.AssignedAt); err != nil {
+			return nil, err
+		}
+		overdue = append(overdue, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return overdue, nil
+}
+
+func (r *PostgresRepository) MarkEscalationAttempted(ctx context.Context, prID string, reviewerID string, at time.Time) error {
+	query, args, err := r.sb.
+		Update("assigned_pr_reviewers").
+		Set("escalation_attempted_at", at).
+		Where(sq.Eq{"pr_id": prID, "reviewer_id": reviewerID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
 }
 
 func (r *PostgresRepository) GetByAssignedReviewer(ctx context.Context, userID string) ([]entity.PullRequest, error) {
 	query, args, err := r.sb.
-		Select("pr.id", "pr.name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at").
+		Select(
+			"pr.id", "pr.name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at", "pr.version",
+			"apr.reviewer_id", "apr.assigned_at", "apr.decision", "apr.decided_at",
+		).
 		From("pullrequests pr").
 		Join("assigned_pr_reviewers apr ON pr.id = apr.pr_id").
 		Where(sq.Eq{"apr.reviewer_id": userID}).
@@ -165,9 +301,19 @@ func (r *PostgresRepository) GetByAssignedReviewer(ctx context.Context, userID s
 	var prs []entity.PullRequest
 	for rows.Next() {
 		var pr entity.PullRequest
-		if err := rows.Scan(&pr.PullRequestId, &pr.PullRequestName, &pr.AuthorId, &pr.Status, &pr.CreatedAt, &pr.MergedAt); err != nil {
+		var review entity.ReviewAssignment
+		var decision *string
+		if err := rows.Scan(
+			&pr.PullRequestId, &pr.PullRequestName, &pr.AuthorId, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.Version,
+			&review.ReviewerID, &review.AssignedAt, &decision, &review.DecidedAt,
+		); err != nil {
 			return nil, err
 		}
+		if decision != nil {
+			review.Decision = entity.ReviewDecision(*decision)
+		}
+		// только назначение запрошенного ревьювера, остальные не выбираются
+		pr.Reviews = []entity.ReviewAssignment{review}
 		prs = append(prs, pr)
 	}
 
@@ -178,6 +324,18 @@ func (r *PostgresRepository) GetByAssignedReviewer(ctx context.Context, userID s
 	return prs, nil
 }
 
+func scanReview(row db.Row) (entity.ReviewAssignment, error) {
+	var review entity.ReviewAssignment
+	var decision *string
+	if err := row.Scan(&review.ReviewerID, &review.AssignedAt, &decision, &review.DecidedAt); err != nil {
+		return entity.ReviewAssignment{}, err
+	}
+	if decision != nil {
+		review.Decision = entity.ReviewDecision(*decision)
+	}
+	return review, nil
+}
+
 func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
 	if transactional, ok := r.db.(db.Transactional); ok {
 		return transactional.BeginTx(ctx)
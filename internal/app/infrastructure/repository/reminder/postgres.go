@@ -0,0 +1,98 @@
+package reminder
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/reminder"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) reminder.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) ListStale(ctx context.Context, waitingBefore time.Time, remindedBefore time.Time, limit uint64) ([]entity.StaleReview, error) {
+	query, args, err := r.sb.
+		Select("pr.id", "pr.name", "pr.author_id", "u.team_name", "apr.reviewer_id", "apr.assigned_at").
+		From("pullrequests pr").
+		Join("assigned_pr_reviewers apr ON apr.pr_id = pr.id").
+		Join("users u ON u.id = pr.author_id").
+		LeftJoin("review_reminders rr ON rr.pr_id = apr.pr_id AND rr.reviewer_id = apr.reviewer_id").
+		Where(sq.Eq{"pr.status": string(entity.PullRequestStatusOPEN)}).
+		Where(sq.Eq{"apr.decision": nil}).
+		Where(sq.LtOrEq{"apr.assigned_at": waitingBefore}).
+		Where(sq.Or{
+			sq.Eq{"rr.reminded_at": nil},
+			sq.LtOrEq{"rr.reminded_at": remindedBefore},
+		}).
+		OrderBy("apr.assigned_at", "pr.id", "apr.reviewer_id").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []entity.StaleReview
+	for rows.Next() {
+		var review entity.StaleReview
+		if err := rows.Scan(
+			&review.PullRequestID, &review.PullRequestName, &review.AuthorID,
+			&review.TeamName, &review.ReviewerID, &review.WaitingSince,
+		); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (r *PostgresRepository) MarkReminded(ctx context.Context, prID string, reviewerID string, remindedAt time.Time) error {
+	query, args, err := r.sb.
+		Insert("review_reminders").
+		Columns("pr_id", "reviewer_id", "reminded_at").
+		Values(prID, reviewerID, remindedAt).
+		Suffix("ON CONFLICT (pr_id, reviewer_id) DO UPDATE SET reminded_at = EXCLUDED.reminded_at").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
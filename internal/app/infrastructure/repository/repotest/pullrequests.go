@@ -0,0 +1,308 @@
+package repotest
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func runPullRequests(t *testing.T, open func(t *testing.T) Repositories) {
+	ctx := context.Background()
+
+	// setup создаёт команду из автора и ревьюверов r1..r3
+	setup := func(t *testing.T) (Repositories, namespace, string) {
+		repos := open(t)
+		ns := newNamespace()
+		teamName := ns.id("team")
+		mustCreateTeam(t, repos, teamName)
+		for _, name := range []string{"author", "r1", "r2", "r3"} {
+			u := entity.User{UserId: ns.id(name), Username: name, TeamName: teamName, IsActive: true}
+			if err := repos.Users.Create(ctx, u); err != nil {
+				t.Fatalf("create user %s: %v", name, err)
+			}
+		}
+		return repos, ns, teamName
+	}
+
+	newPR := func(ns namespace, id string, createdAt time.Time, reviewers ...string) entity.PullRequest {
+		createdAt = timestamp(createdAt)
+		pr := entity.PullRequest{
+			PullRequestId:   ns.id(id),
+			PullRequestName: "PR " + id,
+			AuthorId:        ns.id("author"),
+			Status:          entity.PullRequestStatusOPEN,
+			CreatedAt:       &createdAt,
+		}
+		for _, r := range reviewers {
+			pr.AssignedReviewers = append(pr.AssignedReviewers, ns.id(r))
+		}
+		return pr
+	}
+
+	mustCreate := func(t *testing.T, repos Repositories, pr entity.PullRequest) {
+		t.Helper()
+		if err := repos.PullRequests.Create(ctx, pr); err != nil {
+			t.Fatalf("create pr %s: %v", pr.PullRequestId, err)
+		}
+	}
+
+	mustGet := func(t *testing.T, repos Repositories, id string) *entity.PullRequest {
+		t.Helper()
+		pr, err := repos.PullRequests.GetByID(ctx, id)
+		if err != nil {
+			t.Fatalf("get pr %s: %v", id, err)
+		}
+		if pr == nil {
+			t.Fatalf("pr %s not found", id)
+		}
+		return pr
+	}
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		created := timestamp(time.Now())
+		mustCreate(t, repos, newPR(ns, "pr1", created, "r2", "r1"))
+
+		got := mustGet(t, repos, ns.id("pr1"))
+		if got.PullRequestName != "PR pr1" || got.AuthorId != ns.id("author") || got.Status != entity.PullRequestStatusOPEN {
+			t.Fatalf("unexpected pr %+v", got)
+		}
+		if got.CreatedAt == nil || !got.CreatedAt.Equal(created) || got.MergedAt != nil {
+			t.Fatalf("unexpected timestamps: created %v, merged %v", got.CreatedAt, got.MergedAt)
+		}
+		// назначенные одновременно ревьюверы упорядочены по id
+		assertIDs(t, "reviewers", got.AssignedReviewers, ns.id("r1"), ns.id("r2"))
+		if len(got.Reviews) != 2 {
+			t.Fatalf("expected 2 reviews, got %+v", got.Reviews)
+		}
+		for _, review := range got.Reviews {
+			if !review.AssignedAt.Equal(created) || review.Decision != "" || review.DecidedAt != nil {
+				t.Fatalf("unexpected review %+v", review)
+			}
+		}
+	})
+
+	t.Run("GetMissingReturnsNil", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+
+		got, err := repos.PullRequests.GetByID(ctx, ns.id("missing"))
+		if err != nil || got != nil {
+			t.Fatalf("expected nil, nil; got %+v, %v", got, err)
+		}
+	})
+
+	t.Run("GetReportsStorageErrors", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		mustCreate(t, repos, newPR(ns, "pr1", time.Now(), "r1"))
+
+		got, err := repos.PullRequests.GetByID(cancelledContext(), ns.id("pr1"))
+		if err == nil || errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected storage error for cancelled context, got %+v, %v", got, err)
+		}
+	})
+
+	t.Run("CreateViolationsFail", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		mustCreate(t, repos, newPR(ns, "pr1", time.Now(), "r1"))
+
+		err := repos.PullRequests.Create(ctx, newPR(ns, "pr1", time.Now(), "r2"))
+		if !db.IsConstraint(err, db.ErrConflict, "pullrequests_pkey") {
+			t.Fatalf("expected pullrequests_pkey conflict, got %v", err)
+		}
+
+		orphan := newPR(ns, "pr2", time.Now())
+		orphan.AuthorId = ns.id("ghost")
+		if err := repos.PullRequests.Create(ctx, orphan); !errors.Is(err, db.ErrForeignKey) {
+			t.Fatalf("expected ErrForeignKey for unknown author, got %v", err)
+		}
+	})
+
+	t.Run("UpdateStatus", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		mustCreate(t, repos, newPR(ns, "pr1", time.Now(), "r1"))
+
+		mergedAt := timestamp(time.Now())
+		if err := repos.PullRequests.UpdateStatus(ctx, ns.id("pr1"), entity.PullRequestStatusMERGED, &mergedAt); err != nil {
+			t.Fatalf("update status: %v", err)
+		}
+
+		got := mustGet(t, repos, ns.id("pr1"))
+		if got.Status != entity.PullRequestStatusMERGED || got.MergedAt == nil || !got.MergedAt.Equal(mergedAt) {
+			t.Fatalf("expected merged pr, got %+v", got)
+		}
+		assertIDs(t, "reviewers", got.AssignedReviewers, ns.id("r1"))
+	})
+
+	t.Run("ReplaceReviewer", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		created := time.Now().Add(-time.Hour)
+		mustCreate(t, repos, newPR(ns, "pr1", created, "r1", "r2"))
+
+		reassignedAt := timestamp(time.Now())
+		if err := repos.PullRequests.ReplaceReviewer(ctx, ns.id("pr1"), ns.id("r1"), ns.id("r3"), reassignedAt); err != nil {
+			t.Fatalf("replace reviewer: %v", err)
+		}
+
+		got := mustGet(t, repos, ns.id("pr1"))
+		// порядок — по времени назначения
+		assertIDs(t, "reviewers", got.AssignedReviewers, ns.id("r2"), ns.id("r3"))
+		if !got.Reviews[1].AssignedAt.Equal(reassignedAt) {
+			t.Fatalf("new reviewer must be assigned at %v, got %v", reassignedAt, got.Reviews[1].AssignedAt)
+		}
+
+		err := repos.PullRequests.ReplaceReviewer(ctx, ns.id("pr1"), ns.id("r1"), ns.id("author"), reassignedAt)
+		if !errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound for unassigned reviewer, got %v", err)
+		}
+	})
+
+	t.Run("SetDecision", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		mustCreate(t, repos, newPR(ns, "pr1", time.Now(), "r1", "r2"))
+
+		decidedAt := timestamp(time.Now())
+		err := repos.PullRequests.SetDecision(ctx, ns.id("pr1"), ns.id("r2"), entity.ReviewDecisionApproved, decidedAt)
+		if err != nil {
+			t.Fatalf("set decision: %v", err)
+		}
+
+		got := mustGet(t, repos, ns.id("pr1"))
+		for _, review := range got.Reviews {
+			decided := review.ReviewerID == ns.id("r2")
+			if decided != (review.Decision == entity.ReviewDecisionApproved) ||
+				decided != (review.DecidedAt != nil && review.DecidedAt.Equal(decidedAt)) {
+				t.Fatalf("unexpected review %+v", review)
+			}
+		}
+	})
+
+	t.Run("Version", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		mustCreate(t, repos, newPR(ns, "pr1", time.Now().Add(-time.Hour), "r1", "r2"))
+
+		if got := mustGet(t, repos, ns.id("pr1")); got.Version != 1 {
+			t.Fatalf("expected version 1 for new pr, got %d", got.Version)
+		}
+
+		now := timestamp(time.Now())
+		if err := repos.PullRequests.SetDecision(ctx, ns.id("pr1"), ns.id("r2"), entity.ReviewDecisionApproved, now); err != nil {
+			t.Fatalf("set decision: %v", err)
+		}
+		if err := repos.PullRequests.ReplaceReviewer(ctx, ns.id("pr1"), ns.id("r1"), ns.id("r3"), now); err != nil {
+			t.Fatalf("replace reviewer: %v", err)
+		}
+		if err := repos.PullRequests.UpdateStatus(ctx, ns.id("pr1"), entity.PullRequestStatusMERGED, &now); err != nil {
+			t.Fatalf("update status: %v", err)
+		}
+
+		// каждое изменение увеличивает версию ровно на единицу
+		if got := mustGet(t, repos, ns.id("pr1")); got.Version != 4 {
+			t.Fatalf("expected version 4 after three updates, got %d", got.Version)
+		}
+		if version, err := repos.PullRequests.LockVersion(ctx, ns.id("pr1")); err != nil || version != 4 {
+			t.Fatalf("expected locked version 4, got %d (%v)", version, err)
+		}
+		if _, err := repos.PullRequests.LockVersion(ctx, ns.id("missing")); !errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound for missing pr, got %v", err)
+		}
+	})
+
+	t.Run("GetByAssignedReviewer", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		mustCreate(t, repos, newPR(ns, "pr1", time.Now(), "r1", "r2"))
+		mustCreate(t, repos, newPR(ns, "pr2", time.Now(), "r2"))
+		mustCreate(t, repos, newPR(ns, "pr3", time.Now(), "r3"))
+
+		prs, err := repos.PullRequests.GetByAssignedReviewer(ctx, ns.id("r2"))
+		if err != nil {
+			t.Fatalf("get by reviewer: %v", err)
+		}
+		if len(prs) != 2 {
+			t.Fatalf("expected 2 prs, got %+v", prs)
+		}
+		for _, pr := range prs {
+			if pr.PullRequestId != ns.id("pr1") && pr.PullRequestId != ns.id("pr2") {
+				t.Fatalf("unexpected pr %s", pr.PullRequestId)
+			}
+			// Reviews содержит только назначение запрошенного ревьювера
+			if len(pr.Reviews) != 1 || pr.Reviews[0].ReviewerID != ns.id("r2") {
+				t.Fatalf("unexpected reviews of %s: %+v", pr.PullRequestId, pr.Reviews)
+			}
+		}
+	})
+
+	t.Run("ListOverdue", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+		slowTeam := ns.id("slow")
+		mustCreateTeam(t, repos, slowTeam)
+		if err := repos.Teams.SetReviewSLA(ctx, slowTeam, 3*time.Hour); err != nil {
+			t.Fatalf("set sla: %v", err)
+		}
+		if err := repos.Users.Create(ctx, entity.User{UserId: ns.id("slow-author"), Username: "slow", TeamName: slowTeam, IsActive: true}); err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+
+		now := timestamp(time.Now())
+		old := now.Add(-2 * time.Hour)
+		older := now.Add(-150 * time.Minute)
+
+		mustCreate(t, repos, newPR(ns, "late", old, "r1", "r2"))
+		decided := newPR(ns, "decided", older, "r1", "r3")
+		mustCreate(t, repos, decided)
+		if err := repos.PullRequests.SetDecision(ctx, decided.PullRequestId, ns.id("r1"), entity.ReviewDecisionApproved, now); err != nil {
+			t.Fatalf("set decision: %v", err)
+		}
+		merged := newPR(ns, "merged", old, "r1")
+		mustCreate(t, repos, merged)
+		if err := repos.PullRequests.UpdateStatus(ctx, merged.PullRequestId, entity.PullRequestStatusMERGED, &now); err != nil {
+			t.Fatalf("merge: %v", err)
+		}
+		mustCreate(t, repos, newPR(ns, "fresh", now, "r1"))
+		withinTeamSLA := newPR(ns, "slow", old, "r1")
+		withinTeamSLA.AuthorId = ns.id("slow-author")
+		mustCreate(t, repos, withinTeamSLA)
+
+		overdue, err := repos.PullRequests.ListOverdue(ctx, now, time.Hour, 1000)
+		if err != nil {
+			t.Fatalf("list overdue: %v", err)
+		}
+		var got []string
+		for _, o := range overdue {
+			if ns.owns(o.PullRequestID) {
+				got = append(got, o.PullRequestID+"/"+o.ReviewerID)
+			}
+		}
+		// сначала самые давние назначения
+		assertIDs(t, "overdue reviews", got,
+			ns.id("decided")+"/"+ns.id("r3"),
+			ns.id("late")+"/"+ns.id("r1"),
+			ns.id("late")+"/"+ns.id("r2"),
+		)
+
+		// назначения с неудачной эскалацией уходят в конец, начиная с самой давней попытки
+		if err := repos.PullRequests.MarkEscalationAttempted(ctx, ns.id("decided"), ns.id("r3"), now); err != nil {
+			t.Fatalf("mark escalation: %v", err)
+		}
+		if err := repos.PullRequests.MarkEscalationAttempted(ctx, ns.id("late"), ns.id("r1"), now.Add(-time.Minute)); err != nil {
+			t.Fatalf("mark escalation: %v", err)
+		}
+		overdue, err = repos.PullRequests.ListOverdue(ctx, now, time.Hour, 1000)
+		if err != nil {
+			t.Fatalf("list overdue: %v", err)
+		}
+		got = nil
+		for _, o := range overdue {
+			if ns.owns(o.PullRequestID) {
+				got = append(got, o.PullRequestID+"/"+o.ReviewerID)
+			}
+		}
+		assertIDs(t, "overdue reviews after attempts", got,
+			ns.id("late")+"/"+ns.id("r2"),
+			ns.id("late")+"/"+ns.id("r1"),
+			ns.id("decided")+"/"+ns.id("r3"),
+		)
+	})
+}
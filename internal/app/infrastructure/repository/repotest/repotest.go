@@ -0,0 +1,73 @@
+// Package repotest — контрактные тесты репозиториев пользователей, команд и
+// PR. Один и тот же набор прогоняется для каждой реализации, чтобы они вели
+// себя одинаково: nil вместо ошибки для отсутствующей записи, ошибки
+// ограничений, порядок выборок и откат через WithDB.
+//
+// Тесты не рассчитывают на пустую базу: все идентификаторы получают
+// уникальный префикс, а выборки фильтруются по нему.
+package repotest
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/team"
+	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type Repositories struct {
+	DB           db.TransactionalDB
+	Users        user.Repository
+	Teams        team.Repository
+	PullRequests pullrequest.Repository
+}
+
+// Run прогоняет весь контракт. open вызывается для каждого подтеста и может
+// как создавать новое хранилище, так и возвращать общее.
+func Run(t *testing.T, open func(t *testing.T) Repositories) {
+	t.Run("Users", func(t *testing.T) { runUsers(t, open) })
+	t.Run("Teams", func(t *testing.T) { runTeams(t, open) })
+	t.Run("PullRequests", func(t *testing.T) { runPullRequests(t, open) })
+	t.Run("Transactions", func(t *testing.T) { runTransactions(t, open) })
+}
+
+var namespaceSeq atomic.Int64
+
+// namespace выдаёт идентификаторы, не пересекающиеся с другими тестами и
+// предыдущими прогонами на той же базе.
+type namespace string
+
+func newNamespace() namespace {
+	return namespace(fmt.Sprintf("rt%d-%d-", time.Now().UnixNano(), namespaceSeq.Add(1)))
+}
+
+func (ns namespace) id(name string) string {
+	return string(ns) + name
+}
+
+func (ns namespace) owns(id string) bool {
+	return strings.HasPrefix(id, string(ns))
+}
+
+// timestamp округляет время до микросекунд — точности TIMESTAMPTZ.
+func timestamp(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+func cancelledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+func mustCreateTeam(t *testing.T, repos Repositories, name string) {
+	t.Helper()
+	if err := repos.Teams.Create(context.Background(), name); err != nil {
+		t.Fatalf("create team %s: %v", name, err)
+	}
+}
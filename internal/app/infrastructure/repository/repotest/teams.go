@@ -0,0 +1,122 @@
+package repotest
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func runTeams(t *testing.T, open func(t *testing.T) Repositories) {
+	ctx := context.Background()
+
+	t.Run("CreateAndExists", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+
+		mustCreateTeam(t, repos, ns.id("backend"))
+
+		exists, err := repos.Teams.Exists(ctx, ns.id("backend"))
+		if err != nil || !exists {
+			t.Fatalf("expected team to exist, got %v (%v)", exists, err)
+		}
+		exists, err = repos.Teams.Exists(ctx, ns.id("missing"))
+		if err != nil || exists {
+			t.Fatalf("expected missing team not to exist, got %v (%v)", exists, err)
+		}
+	})
+
+	t.Run("ExistsReportsStorageErrors", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+		mustCreateTeam(t, repos, ns.id("backend"))
+
+		if _, err := repos.Teams.Exists(cancelledContext(), ns.id("backend")); err == nil {
+			t.Fatal("expected error for cancelled context")
+		}
+	})
+
+	t.Run("CreateDuplicateFails", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+
+		mustCreateTeam(t, repos, ns.id("backend"))
+		err := repos.Teams.Create(ctx, ns.id("backend"))
+		if !db.IsConstraint(err, db.ErrConflict, "teams_pkey") {
+			t.Fatalf("expected teams_pkey conflict, got %v", err)
+		}
+	})
+
+	t.Run("ListIsSorted", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+		for _, name := range []string{"gamma", "alpha", "beta"} {
+			mustCreateTeam(t, repos, ns.id(name))
+		}
+
+		names, err := repos.Teams.List(ctx)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		var own []string
+		for _, name := range names {
+			if ns.owns(name) {
+				own = append(own, name)
+			}
+		}
+		assertIDs(t, "teams", own, ns.id("alpha"), ns.id("beta"), ns.id("gamma"))
+	})
+
+	t.Run("ReviewSLA", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+		mustCreateTeam(t, repos, ns.id("backend"))
+
+		sla, err := repos.Teams.GetReviewSLA(ctx, ns.id("backend"))
+		if err != nil || sla != nil {
+			t.Fatalf("expected no SLA by default, got %v (%v)", sla, err)
+		}
+		sla, err = repos.Teams.GetReviewSLA(ctx, ns.id("missing"))
+		if err != nil || sla != nil {
+			t.Fatalf("expected no SLA for missing team, got %v (%v)", sla, err)
+		}
+
+		if err := repos.Teams.SetReviewSLA(ctx, ns.id("backend"), 90*time.Minute); err != nil {
+			t.Fatalf("set sla: %v", err)
+		}
+		sla, err = repos.Teams.GetReviewSLA(ctx, ns.id("backend"))
+		if err != nil || sla == nil || *sla != 90*time.Minute {
+			t.Fatalf("expected 1h30m, got %v (%v)", sla, err)
+		}
+	})
+
+	t.Run("Version", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+		mustCreateTeam(t, repos, ns.id("backend"))
+
+		if version, err := repos.Teams.Version(ctx, ns.id("backend")); err != nil || version != 1 {
+			t.Fatalf("expected version 1 for new team, got %d (%v)", version, err)
+		}
+		if err := repos.Teams.SetReviewSLA(ctx, ns.id("backend"), time.Hour); err != nil {
+			t.Fatalf("set sla: %v", err)
+		}
+		if version, err := repos.Teams.BumpVersion(ctx, ns.id("backend")); err != nil || version != 3 {
+			t.Fatalf("expected version 3 after sla and bump, got %d (%v)", version, err)
+		}
+		if version, err := repos.Teams.LockVersion(ctx, ns.id("backend")); err != nil || version != 3 {
+			t.Fatalf("expected locked version 3, got %d (%v)", version, err)
+		}
+
+		if _, err := repos.Teams.Version(ctx, ns.id("missing")); !errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound for missing team, got %v", err)
+		}
+		if _, err := repos.Teams.LockVersion(ctx, ns.id("missing")); !errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound for missing team, got %v", err)
+		}
+		if _, err := repos.Teams.BumpVersion(ctx, ns.id("missing")); !errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound for missing team, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package repotest
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"testing"
+)
+
+func runTransactions(t *testing.T, open func(t *testing.T) Repositories) {
+	ctx := context.Background()
+	errAbort := errors.New("abort")
+
+	// write создаёт в транзакции команду с участником и PR
+	write := func(ctx context.Context, repos Repositories, tx db.Tx, ns namespace) error {
+		if err := repos.Teams.WithDB(tx).Create(ctx, ns.id("team")); err != nil {
+			return err
+		}
+		u := entity.User{UserId: ns.id("author"), Username: "author", TeamName: ns.id("team"), IsActive: true}
+		if err := repos.Users.WithDB(tx).Create(ctx, u); err != nil {
+			return err
+		}
+		return repos.PullRequests.WithDB(tx).Create(ctx, entity.PullRequest{
+			PullRequestId:   ns.id("pr1"),
+			PullRequestName: "PR",
+			AuthorId:        u.UserId,
+			Status:          entity.PullRequestStatusOPEN,
+		})
+	}
+
+	visible := func(t *testing.T, repos Repositories, ns namespace) (team, user, pr bool) {
+		t.Helper()
+		team, err := repos.Teams.Exists(ctx, ns.id("team"))
+		if err != nil {
+			t.Fatalf("exists: %v", err)
+		}
+		u, err := repos.Users.GetByID(ctx, ns.id("author"))
+		if err != nil {
+			t.Fatalf("get user: %v", err)
+		}
+		p, err := repos.PullRequests.GetByID(ctx, ns.id("pr1"))
+		if err != nil {
+			t.Fatalf("get pr: %v", err)
+		}
+		return team, u != nil, p != nil
+	}
+
+	t.Run("CommitPublishesAllWrites", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+
+		err := db.WithTx(ctx, repos.DB, func(ctx context.Context, tx db.Tx) error {
+			if err := write(ctx, repos, tx, ns); err != nil {
+				return err
+			}
+			// транзакция видит свои записи, остальные — нет
+			if u, err := repos.Users.WithDB(tx).GetByID(ctx, ns.id("author")); err != nil || u == nil {
+				t.Errorf("tx must see its own user, got %+v (%v)", u, err)
+			}
+			if team, user, pr := visible(t, repos, ns); team || user || pr {
+				t.Errorf("uncommitted writes are visible: team=%v user=%v pr=%v", team, user, pr)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("tx: %v", err)
+		}
+
+		if team, user, pr := visible(t, repos, ns); !team || !user || !pr {
+			t.Fatalf("committed writes are missing: team=%v user=%v pr=%v", team, user, pr)
+		}
+	})
+
+	t.Run("RollbackDiscardsAllWrites", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+
+		err := db.WithTx(ctx, repos.DB, func(ctx context.Context, tx db.Tx) error {
+			if err := write(ctx, repos, tx, ns); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		if !errors.Is(err, errAbort) {
+			t.Fatalf("expected errAbort, got %v", err)
+		}
+
+		if team, user, pr := visible(t, repos, ns); team || user || pr {
+			t.Fatalf("rolled back writes are visible: team=%v user=%v pr=%v", team, user, pr)
+		}
+	})
+
+	t.Run("RollbackRestoresUpdatedRows", func(t *testing.T) {
+		repos := open(t)
+		ns := newNamespace()
+		mustCreateTeam(t, repos, ns.id("team"))
+		before := entity.User{UserId: ns.id("u1"), Username: "Alice", TeamName: ns.id("team"), IsActive: true}
+		if err := repos.Users.Create(ctx, before); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		before.Version = 1
+
+		err := db.WithTx(ctx, repos.DB, func(ctx context.Context, tx db.Tx) error {
+			changed := before
+			changed.IsActive = false
+			if err := repos.Users.WithDB(tx).Update(ctx, changed); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		if !errors.Is(err, errAbort) {
+			t.Fatalf("expected errAbort, got %v", err)
+		}
+
+		got, err := repos.Users.GetByID(ctx, before.UserId)
+		if err != nil || got == nil || *got != before {
+			t.Fatalf("expected %+v after rollback, got %+v (%v)", before, got, err)
+		}
+	})
+}
@@ -0,0 +1,194 @@
+package repotest
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func runUsers(t *testing.T, open func(t *testing.T) Repositories) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (Repositories, namespace, string) {
+		repos := open(t)
+		ns := newNamespace()
+		teamName := ns.id("team")
+		mustCreateTeam(t, repos, teamName)
+		return repos, ns, teamName
+	}
+
+	t.Run("CreateThenGet", func(t *testing.T) {
+		repos, ns, teamName := setup(t)
+		want := entity.User{UserId: ns.id("u1"), Username: "Alice", TeamName: teamName, IsActive: true}
+
+		if err := repos.Users.Create(ctx, want); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		want.Version = 1
+		got, err := repos.Users.GetByID(ctx, want.UserId)
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if got == nil || *got != want {
+			t.Fatalf("expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("GetMissingReturnsNil", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+
+		got, err := repos.Users.GetByID(ctx, ns.id("missing"))
+		if err != nil || got != nil {
+			t.Fatalf("expected nil, nil; got %+v, %v", got, err)
+		}
+	})
+
+	t.Run("GetReportsStorageErrors", func(t *testing.T) {
+		repos, ns, teamName := setup(t)
+		u := entity.User{UserId: ns.id("u1"), Username: "Alice", TeamName: teamName, IsActive: true}
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+
+		// сбой хранилища не должен выглядеть как «пользователь не найден»
+		got, err := repos.Users.GetByID(cancelledContext(), u.UserId)
+		if err == nil || errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected storage error for cancelled context, got %+v, %v", got, err)
+		}
+	})
+
+	t.Run("CreateDuplicateFails", func(t *testing.T) {
+		repos, ns, teamName := setup(t)
+		u := entity.User{UserId: ns.id("u1"), Username: "Alice", TeamName: teamName, IsActive: true}
+
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		u.Username = "Alice again"
+		if err := repos.Users.Create(ctx, u); !errors.Is(err, db.ErrConflict) {
+			t.Fatalf("expected ErrConflict for duplicate user, got %v", err)
+		}
+	})
+
+	t.Run("CreateWithUnknownTeamFails", func(t *testing.T) {
+		repos, ns, _ := setup(t)
+
+		err := repos.Users.Create(ctx, entity.User{UserId: ns.id("u1"), Username: "Alice", TeamName: ns.id("nope")})
+		if !errors.Is(err, db.ErrForeignKey) {
+			t.Fatalf("expected ErrForeignKey for unknown team, got %v", err)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		repos, ns, teamName := setup(t)
+		otherTeam := ns.id("other")
+		mustCreateTeam(t, repos, otherTeam)
+
+		u := entity.User{UserId: ns.id("u1"), Username: "Alice", TeamName: teamName, IsActive: true}
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+		u.Username = "Alice B."
+		u.TeamName = otherTeam
+		u.IsActive = false
+		if err := repos.Users.Update(ctx, u); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+
+		u.Version = 2
+		got, err := repos.Users.GetByID(ctx, u.UserId)
+		if err != nil || got == nil || *got != u {
+			t.Fatalf("expected %+v, got %+v (%v)", u, got, err)
+		}
+		if version, err := repos.Users.LockVersion(ctx, u.UserId); err != nil || version != 2 {
+			t.Fatalf("expected locked version 2, got %d (%v)", version, err)
+		}
+		if _, err := repos.Users.LockVersion(ctx, ns.id("ghost")); !errors.Is(err, db.ErrNotFound) {
+			t.Fatalf("expected ErrNotFound for missing user, got %v", err)
+		}
+	})
+
+	t.Run("UpdateMissingIsNoop", func(t *testing.T) {
+		repos, ns, teamName := setup(t)
+		u := entity.User{UserId: ns.id("ghost"), Username: "Ghost", TeamName: teamName}
+
+		if err := repos.Users.Update(ctx, u); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+		if got, err := repos.Users.GetByID(ctx, u.UserId); err != nil || got != nil {
+			t.Fatalf("update must not create a user, got %+v (%v)", got, err)
+		}
+	})
+
+	t.Run("ListsAreOrderedByID", func(t *testing.T) {
+		repos, ns, teamName := setup(t)
+		otherTeam := ns.id("other")
+		mustCreateTeam(t, repos, otherTeam)
+
+		for _, u := range []entity.User{
+			{UserId: ns.id("c"), Username: "C", TeamName: teamName, IsActive: true},
+			{UserId: ns.id("a"), Username: "A", TeamName: teamName},
+			{UserId: ns.id("b"), Username: "B", TeamName: otherTeam, IsActive: true},
+		} {
+			if err := repos.Users.Create(ctx, u); err != nil {
+				t.Fatalf("create %s: %v", u.UserId, err)
+			}
+		}
+
+		members, err := repos.Users.GetByTeam(ctx, teamName)
+		if err != nil {
+			t.Fatalf("get by team: %v", err)
+		}
+		assertIDs(t, "team members", userIDs(members), ns.id("a"), ns.id("c"))
+
+		all, err := repos.Users.List(ctx)
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		var own []entity.User
+		for _, u := range all {
+			if ns.owns(u.UserId) {
+				own = append(own, u)
+			}
+		}
+		assertIDs(t, "all users", userIDs(own), ns.id("a"), ns.id("b"), ns.id("c"))
+	})
+
+	t.Run("RecordActivity", func(t *testing.T) {
+		repos, ns, teamName := setup(t)
+		u := entity.User{UserId: ns.id("u1"), Username: "Alice", TeamName: teamName, IsActive: true}
+		if err := repos.Users.Create(ctx, u); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+
+		if err := repos.Users.RecordActivity(ctx, u.UserId, false, time.Now()); err != nil {
+			t.Fatalf("record activity: %v", err)
+		}
+		if err := repos.Users.RecordActivity(ctx, ns.id("ghost"), false, time.Now()); !errors.Is(err, db.ErrForeignKey) {
+			t.Fatalf("expected ErrForeignKey for unknown user, got %v", err)
+		}
+	})
+}
+
+func userIDs(users []entity.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.UserId)
+	}
+	return ids
+}
+
+func assertIDs(t *testing.T, what string, got []string, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: expected %v, got %v", what, want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s: expected %v, got %v", what, want, got)
+		}
+	}
+}
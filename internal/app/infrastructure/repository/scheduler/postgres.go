@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/scheduler"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) scheduler.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) TryLock(ctx context.Context, jobName string) (bool, error) {
+	var locked bool
+	if err := r.db.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock(hashtext($1))", jobName).Scan(&locked); err != nil {
+		return false, err
+	}
+	return locked, nil
+}
+
+func (r *PostgresRepository) LastRun(ctx context.Context, jobName string) (*time.Time, error) {
+	query, args, err := r.sb.
+		Select("last_run_at").
+		From("scheduler_jobs").
+		Where(sq.Eq{"name": jobName}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var lastRun time.Time
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&lastRun); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &lastRun, nil
+}
+
+func (r *PostgresRepository) SetLastRun(ctx context.Context, jobName string, at time.Time) error {
+	query, args, err := r.sb.
+		Insert("scheduler_jobs").
+		Columns("name", "last_run_at").
+		Values(jobName, at).
+		Suffix("ON CONFLICT (name) DO UPDATE SET last_run_at = EXCLUDED.last_run_at").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
@@ -0,0 +1,330 @@
+package stats
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/stats"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// assignmentsSource объединяет текущие назначения и назначения, с которых
+// ревьювер был снят: вторые нужны для подсчёта общего числа назначений и
+// переназначений.
+const assignmentsSource = `(
+	SELECT reviewer_id, pr_id, assigned_at, NULL::timestamptz AS reassigned_at, true AS current
+	FROM assigned_pr_reviewers
+	UNION ALL
+	SELECT old_reviewer_id, pr_id, assigned_at, reassigned_at, false
+	FROM reviewer_reassignments
+) a ON a.reviewer_id = u.id`
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) stats.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+// aggregates строит агрегаты статистики в порядке полей entity.ReviewStats.
+func aggregates(window entity.StatsWindow) []sq.Sqlizer {
+	merged := "a.current AND pr.status = 'MERGED' AND pr.merged_at >= ? AND pr.merged_at < ?"
+	return []sq.Sqlizer{
+		sq.Expr("COUNT(*) FILTER (WHERE a.current AND pr.status = 'OPEN')"),
+		sq.Expr("COUNT(*) FILTER (WHERE a.assigned_at >= ? AND a.assigned_at < ?)", window.From, window.To),
+		sq.Expr("COUNT(*) FILTER (WHERE NOT a.current AND a.reassigned_at >= ? AND a.reassigned_at < ?)", window.From, window.To),
+		sq.Expr("COUNT(*) FILTER (WHERE "+merged+")", window.From, window.To),
+		sq.Expr(
+			"percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - a.assigned_at)) FILTER (WHERE "+merged+")",
+			window.From, window.To,
+		),
+	}
+}
+
+func (r *PostgresRepository) ReviewerStats(ctx context.Context, window entity.StatsWindow, teamName string) ([]entity.ReviewerStats, error) {
+	builder := r.sb.
+		Select("u.id", "u.username", "u.team_name").
+		From("users u").
+		LeftJoin(assignmentsSource).
+		LeftJoin("pullrequests pr ON pr.id = a.pr_id").
+		GroupBy("u.id", "u.username", "u.team_name").
+		OrderBy("u.team_name", "u.id")
+	for _, agg := range aggregates(window) {
+		builder = builder.Column(agg)
+	}
+	if teamName != "" {
+		builder = builder.Where(sq.Eq{"u.team_name": teamName})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entity.ReviewerStats
+	for rows.Next() {
+		var s entity.ReviewerStats
+		var median *float64
+		if err := rows.Scan(
+			&s.UserID, &s.Username, &s.TeamName,
+			&s.OpenAssignments, &s.TotalAssignments, &s.ReassignedAway, &s.MergedReviewed, &median,
+		); err != nil {
+			return nil, err
+		}
+		s.MedianTimeToMerge = secondsToDuration(median)
+		result = append(result, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PostgresRepository) TeamStats(ctx context.Context, window entity.StatsWindow) ([]entity.TeamStats, error) {
+	builder := r.sb.
+		Select("t.team_name").
+		From("teams t").
+		LeftJoin("users u ON u.team_name = t.team_name").
+		LeftJoin(assignmentsSource).
+		LeftJoin("pullrequests pr ON pr.id = a.pr_id").
+		GroupBy("t.team_name").
+		OrderBy("t.team_name")
+	for _, agg := range aggregates(window) {
+		builder = builder.Column(agg)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entity.TeamStats
+	for rows.Next() {
+		var s entity.TeamStats
+		var median *float64
+		if err := rows.Scan(
+			&s.TeamName,
+			&s.OpenAssignments, &s.TotalAssignments, &s.ReassignedAway, &s.MergedReviewed, &median,
+		); err != nil {
+			return nil, err
+		}
+		s.MedianTimeToMerge = secondsToDuration(median)
+		result = append(result, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PostgresRepository) MemberAssignments(ctx context.Context, window entity.StatsWindow, teamName string) ([]entity.MemberAssignments, error) {
+	query, args, err := r.sb.
+		Select("u.id", "u.username", "u.is_active").
+		Column(sq.Expr("COUNT(*) FILTER (WHERE a.assigned_at >= ? AND a.assigned_at < ?)", window.From, window.To)).
+		From("users u").
+		LeftJoin(assignmentsSource).
+		Where(sq.Eq{"u.team_name": teamName}).
+		GroupBy("u.id", "u.username", "u.is_active").
+		OrderBy("u.id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entity.MemberAssignments
+	for rows.Next() {
+		var m entity.MemberAssignments
+		if err := rows.Scan(&m.UserID, &m.Username, &m.IsActive, &m.Assignments); err != nil {
+			return nil, err
+		}
+		result = append(result, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *PostgresRepository) ActivityChanges(ctx context.Context, teamName string, before time.Time) ([]entity.ActivityChange, error) {
+	query, args, err := r.sb.
+		Select("l.user_id", "l.is_active", "l.changed_at").
+		From("user_activity_log l").
+		Join("users u ON u.id = l.user_id").
+		Where(sq.Eq{"u.team_name": teamName}).
+		Where(sq.Lt{"l.changed_at": before}).
+		OrderBy("l.changed_at", "l.id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entity.ActivityChange
+	for rows.Next() {
+		var c entity.ActivityChange
+		if err := rows.Scan(&c.UserID, &c.IsActive, &c.ChangedAt); err != nil {
+			return nil, err
+		}
+		result = append(result, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// cycleTimeKeys — выражение группировки и дополнительный JOIN для разреза.
+var cycleTimeKeys = map[entity.CycleTimeGroup]struct {
+	key  string
+	join string
+}{
+	entity.CycleTimeByTeam:     {key: "au.team_name"},
+	entity.CycleTimeByAuthor:   {key: "pr.author_id"},
+	entity.CycleTimeByReviewer: {key: "apr.reviewer_id", join: "assigned_pr_reviewers apr ON apr.pr_id = pr.id"},
+}
+
+func percentileExprs(interval string) []sq.Sqlizer {
+	exprs := make([]sq.Sqlizer, 0, 3)
+	for _, p := range []string{"0.5", "0.9", "0.99"} {
+		exprs = append(exprs, sq.Expr(fmt.Sprintf(
+			"percentile_cont(%s) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM %s))", p, interval,
+		)))
+	}
+	return exprs
+}
+
+func (r *PostgresRepository) CycleTime(ctx context.Context, window entity.StatsWindow, group entity.CycleTimeGroup) ([]entity.CycleTimeBucket, error) {
+	keys, ok := cycleTimeKeys[group]
+	if !ok {
+		return nil, fmt.Errorf("unsupported cycle time group %q", group)
+	}
+
+	builder := r.sb.
+		Select(keys.key+" AS key", "date_trunc('week', pr.merged_at AT TIME ZONE 'UTC') AS week", "COUNT(*)").
+		From("pullrequests pr").
+		Join("users au ON au.id = pr.author_id").
+		LeftJoin("LATERAL (SELECT MIN(decided_at) AS decided_at FROM assigned_pr_reviewers WHERE pr_id = pr.id) fr ON true").
+		Where(sq.Eq{"pr.status": string(entity.PullRequestStatusMERGED)}).
+		Where(sq.GtOrEq{"pr.merged_at": window.From}).
+		Where(sq.Lt{"pr.merged_at": window.To}).
+		GroupBy("key", "week").
+		OrderBy("key", "week")
+	if keys.join != "" {
+		builder = builder.Join(keys.join)
+	}
+	for _, expr := range percentileExprs("pr.merged_at - pr.created_at") {
+		builder = builder.Column(expr)
+	}
+	for _, expr := range percentileExprs("fr.decided_at - pr.created_at") {
+		builder = builder.Column(expr)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []entity.CycleTimeBucket
+	for rows.Next() {
+		var b entity.CycleTimeBucket
+		var cycle, review [3]*float64
+		if err := rows.Scan(
+			&b.Key, &b.WeekStart, &b.Merged,
+			&cycle[0], &cycle[1], &cycle[2],
+			&review[0], &review[1], &review[2],
+		); err != nil {
+			return nil, err
+		}
+		b.WeekStart = b.WeekStart.UTC()
+		if p := toPercentiles(cycle); p != nil {
+			b.CycleTime = *p
+		}
+		b.FirstReview = toPercentiles(review)
+		result = append(result, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// toPercentiles возвращает nil, если в группе не было ни одного значения.
+func toPercentiles(seconds [3]*float64) *entity.Percentiles {
+	if seconds[0] == nil {
+		return nil
+	}
+	return &entity.Percentiles{
+		P50: *secondsToDuration(seconds[0]),
+		P90: *secondsToDuration(seconds[1]),
+		P99: *secondsToDuration(seconds[2]),
+	}
+}
+
+func secondsToDuration(seconds *float64) *time.Duration {
+	if seconds == nil {
+		return nil
+	}
+	d := time.Duration(*seconds * float64(time.Second))
+	return &d
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
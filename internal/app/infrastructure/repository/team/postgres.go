@@ -6,9 +6,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/jackc/pgx/v5"
 )
 
 type PostgresRepository struct {
@@ -60,7 +60,7 @@ func (r *PostgresRepository) Exists(ctx context.Context, teamName string) (bool,
 	var dummy int
 	err = row.Scan(&dummy)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
+		if errors.Is(err, db.ErrNotFound) {
 			return false, nil
 		}
 
@@ -70,6 +70,123 @@ func (r *PostgresRepository) Exists(ctx context.Context, teamName string) (bool,
 	return true, nil
 }
 
+func (r *PostgresRepository) List(ctx context.Context) ([]string, error) {
+	query, args, err := r.sb.
+		Select("team_name").
+		From("teams").
+		OrderBy("team_name").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		teams = append(teams, name)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
+func (r *PostgresRepository) GetReviewSLA(ctx context.Context, teamName string) (*time.Duration, error) {
+	query, args, err := r.sb.
+		Select("review_sla_minutes").
+		From("teams").
+		Where(sq.Eq{"team_name": teamName}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var minutes *int64
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&minutes); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if minutes == nil {
+		return nil, nil
+	}
+
+	sla := time.Duration(*minutes) * time.Minute
+	return &sla, nil
+}
+
+func (r *PostgresRepository) SetReviewSLA(ctx context.Context, teamName string, sla time.Duration) error {
+	query, args, err := r.sb.
+		Update("teams").
+		Set("review_sla_minutes", int64(sla/time.Minute)).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"team_name": teamName}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) Version(ctx context.Context, teamName string) (int64, error) {
+	return r.selectVersion(ctx, teamName, "")
+}
+
+func (r *PostgresRepository) LockVersion(ctx context.Context, teamName string) (int64, error) {
+	return r.selectVersion(ctx, teamName, "FOR UPDATE")
+}
+
+func (r *PostgresRepository) selectVersion(ctx context.Context, teamName string, suffix string) (int64, error) {
+	query, args, err := r.sb.
+		Select("version").
+		From("teams").
+		Where(sq.Eq{"team_name": teamName}).
+		Suffix(suffix).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (r *PostgresRepository) BumpVersion(ctx context.Context, teamName string) (int64, error) {
+	query, args, err := r.sb.
+		Update("teams").
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"team_name": teamName}).
+		Suffix("RETURNING version").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
 func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
 	if transactional, ok := r.db.(db.Transactional); ok {
 		return transactional.BeginTx(ctx)
@@ -5,7 +5,9 @@ import (
 	"avito-backend-intern-assignment/internal/app/domain/entity"
 	"avito-backend-intern-assignment/pkg/db"
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 )
@@ -49,6 +51,7 @@ func (r *PostgresRepository) Update(ctx context.Context, user entity.User) error
 		Set("username", user.Username).
 		Set("is_active", user.IsActive).
 		Set("team_name", user.TeamName).
+		Set("version", sq.Expr("version + 1")).
 		Where(sq.Eq{"id": user.UserId}).
 		ToSql()
 	if err != nil {
@@ -59,9 +62,41 @@ func (r *PostgresRepository) Update(ctx context.Context, user entity.User) error
 	return err
 }
 
+func (r *PostgresRepository) LockVersion(ctx context.Context, userID string) (int64, error) {
+	query, args, err := r.sb.
+		Select("version").
+		From("users").
+		Where(sq.Eq{"id": userID}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (r *PostgresRepository) RecordActivity(ctx context.Context, userID string, isActive bool, at time.Time) error {
+	query, args, err := r.sb.
+		Insert("user_activity_log").
+		Columns("user_id", "is_active", "changed_at").
+		Values(userID, isActive, at).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
 func (r *PostgresRepository) GetByID(ctx context.Context, userID string) (*entity.User, error) {
 	query, args, err := r.sb.
-		Select("id", "username", "is_active", "team_name").
+		Select("id", "username", "is_active", "team_name", "version").
 		From("users").
 		Where(sq.Eq{"id": userID}).
 		ToSql()
@@ -71,19 +106,34 @@ func (r *PostgresRepository) GetByID(ctx context.Context, userID string) (*entit
 
 	row := r.db.QueryRow(ctx, query, args...)
 	var u entity.User
-	if err := row.Scan(&u.UserId, &u.Username, &u.IsActive, &u.TeamName); err != nil {
-		return nil, nil
+	if err := row.Scan(&u.UserId, &u.Username, &u.IsActive, &u.TeamName, &u.Version); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
 	}
 
 	return &u, nil
 }
 
 func (r *PostgresRepository) GetByTeam(ctx context.Context, teamName string) ([]entity.User, error) {
-	query, args, err := r.sb.
-		Select("id", "username", "is_active", "team_name").
+	return r.selectUsers(ctx, sq.Eq{"team_name": teamName})
+}
+
+func (r *PostgresRepository) List(ctx context.Context) ([]entity.User, error) {
+	return r.selectUsers(ctx, nil)
+}
+
+func (r *PostgresRepository) selectUsers(ctx context.Context, where sq.Sqlizer) ([]entity.User, error) {
+	builder := r.sb.
+		Select("id", "username", "is_active", "team_name", "version").
 		From("users").
-		Where(sq.Eq{"team_name": teamName}).
-		ToSql()
+		OrderBy("id")
+	if where != nil {
+		builder = builder.Where(where)
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +147,7 @@ func (r *PostgresRepository) GetByTeam(ctx context.Context, teamName string) ([]
 	var users []entity.User
 	for rows.Next() {
 		var u entity.User
-		if err := rows.Scan(&u.UserId, &u.Username, &u.IsActive, &u.TeamName); err != nil {
+		if err := rows.Scan(&u.UserId, &u.Username, &u.IsActive, &u.TeamName, &u.Version); err != nil {
 			return nil, err
 		}
 		users = append(users, u)
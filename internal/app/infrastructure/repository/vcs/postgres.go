@@ -0,0 +1,108 @@
+package vcs
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/vcs"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) vcs.IdentityRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) Upsert(ctx context.Context, identity entity.VCSIdentity) error {
+	query, args, err := r.sb.
+		Insert("vcs_identities").
+		Columns("provider", "login", "user_id", "created_at").
+		Values(string(identity.Provider), identity.Login, identity.UserID, identity.CreatedAt).
+		Suffix("ON CONFLICT (provider, login) DO UPDATE SET user_id = EXCLUDED.user_id").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) ResolveUserID(ctx context.Context, provider entity.VCSProvider, login string) (string, error) {
+	query, args, err := r.sb.
+		Select("user_id").
+		From("vcs_identities").
+		Where(sq.Eq{"provider": string(provider), "login": login}).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var userID string
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&userID); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return userID, nil
+}
+
+func (r *PostgresRepository) ListByUser(ctx context.Context, userID string) ([]entity.VCSIdentity, error) {
+	query, args, err := r.sb.
+		Select("provider", "login", "user_id", "created_at").
+		From("vcs_identities").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("provider", "login").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var identities []entity.VCSIdentity
+	for rows.Next() {
+		var identity entity.VCSIdentity
+		var provider string
+		if err := rows.Scan(&provider, &identity.Login, &identity.UserID, &identity.CreatedAt); err != nil {
+			return nil, err
+		}
+		identity.Provider = entity.VCSProvider(provider)
+		identities = append(identities, identity)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return identities, nil
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
@@ -0,0 +1,249 @@
+package webhook
+
+import (
+	"avito-backend-intern-assignment/internal/app/application/service/webhook"
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+var (
+	subscriptionColumns = []string{"id", "team_name", "url", "secret", "event_types", "created_at"}
+	deliveryColumns     = []string{
+		"id", "subscription_id", "outbox_message_id", "event_type", "payload", "status",
+		"attempts", "next_attempt_at", "last_error", "response_status", "created_at", "delivered_at",
+	}
+)
+
+type PostgresRepository struct {
+	db db.DB
+	sb sq.StatementBuilderType
+}
+
+func NewPostgresRepository(db db.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+		sb: sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
+	}
+}
+
+func (r *PostgresRepository) WithDB(db db.DB) webhook.Repository {
+	return &PostgresRepository{
+		db: db,
+		sb: r.sb,
+	}
+}
+
+func (r *PostgresRepository) CreateSubscription(ctx context.Context, sub entity.WebhookSubscription) (int64, error) {
+	query, args, err := r.sb.
+		Insert("webhook_subscriptions").
+		Columns("team_name", "url", "secret", "event_types", "created_at").
+		Values(sub.TeamName, sub.URL, sub.Secret, sub.EventTypes, sub.CreatedAt).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var id int64
+	if err := r.db.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *PostgresRepository) GetSubscription(ctx context.Context, id int64) (*entity.WebhookSubscription, error) {
+	query, args, err := r.sb.
+		Select(subscriptionColumns...).
+		From("webhook_subscriptions").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var sub entity.WebhookSubscription
+	row := r.db.QueryRow(ctx, query, args...)
+	if err := row.Scan(&sub.ID, &sub.TeamName, &sub.URL, &sub.Secret, &sub.EventTypes, &sub.CreatedAt); err != nil {
+		if errors.Is(err, db.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+func (r *PostgresRepository) ListSubscriptions(ctx context.Context, teamName string) ([]entity.WebhookSubscription, error) {
+	return r.listSubscriptions(ctx, sq.Eq{"team_name": teamName})
+}
+
+func (r *PostgresRepository) ListSubscribers(ctx context.Context, teamName string, eventType string) ([]entity.WebhookSubscription, error) {
+	return r.listSubscriptions(ctx, sq.And{
+		sq.Eq{"team_name": teamName},
+		sq.Expr("? = ANY(event_types)", eventType),
+	})
+}
+
+func (r *PostgresRepository) listSubscriptions(ctx context.Context, pred sq.Sqlizer) ([]entity.WebhookSubscription, error) {
+	query, args, err := r.sb.
+		Select(subscriptionColumns...).
+		From("webhook_subscriptions").
+		Where(pred).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []entity.WebhookSubscription
+	for rows.Next() {
+		var sub entity.WebhookSubscription
+		if err := rows.Scan(&sub.ID, &sub.TeamName, &sub.URL, &sub.Secret, &sub.EventTypes, &sub.CreatedAt); err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return subs, nil
+}
+
+func (r *PostgresRepository) DeleteSubscription(ctx context.Context, id int64) error {
+	query, args, err := r.sb.
+		Delete("webhook_subscriptions").
+		Where(sq.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) EnqueueDeliveries(ctx context.Context, deliveries ...entity.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	builder := r.sb.
+		Insert("webhook_deliveries").
+		Columns("subscription_id", "outbox_message_id", "event_type", "payload", "status", "next_attempt_at", "created_at").
+		Suffix("ON CONFLICT (subscription_id, outbox_message_id) DO NOTHING")
+	for _, d := range deliveries {
+		builder = builder.Values(d.SubscriptionID, d.OutboxMessageID, d.EventType, string(d.Payload), string(d.Status), d.NextAttemptAt, d.CreatedAt)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) FetchDueDeliveries(ctx context.Context, now time.Time, limit uint64) ([]entity.WebhookDelivery, error) {
+	query, args, err := r.sb.
+		Select(deliveryColumns...).
+		From("webhook_deliveries").
+		Where(sq.Eq{"status": string(entity.WebhookDeliveryPending)}).
+		Where(sq.LtOrEq{"next_attempt_at": now}).
+		OrderBy("next_attempt_at", "id").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryDeliveries(ctx, query, args...)
+}
+
+func (r *PostgresRepository) UpdateDelivery(ctx context.Context, delivery entity.WebhookDelivery) error {
+	query, args, err := r.sb.
+		Update("webhook_deliveries").
+		Set("status", string(delivery.Status)).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Set("last_error", delivery.LastError).
+		Set("response_status", delivery.ResponseStatus).
+		Set("delivered_at", delivery.DeliveredAt).
+		Where(sq.Eq{"id": delivery.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.db.Exec(ctx, query, args...)
+}
+
+func (r *PostgresRepository) ListDeliveries(ctx context.Context, filter entity.WebhookDeliveryFilter) ([]entity.WebhookDelivery, error) {
+	builder := r.sb.
+		Select(deliveryColumns...).
+		From("webhook_deliveries").
+		Where(sq.Eq{"subscription_id": filter.SubscriptionID}).
+		OrderBy("id DESC").
+		Limit(filter.Limit)
+	if filter.Status != "" {
+		builder = builder.Where(sq.Eq{"status": string(filter.Status)})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryDeliveries(ctx, query, args...)
+}
+
+func (r *PostgresRepository) queryDeliveries(ctx context.Context, query string, args ...any) ([]entity.WebhookDelivery, error) {
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		var d entity.WebhookDelivery
+		var payload []byte
+		var status string
+		if err := rows.Scan(
+			&d.ID, &d.SubscriptionID, &d.OutboxMessageID, &d.EventType, &payload, &status,
+			&d.Attempts, &d.NextAttemptAt, &d.LastError, &d.ResponseStatus, &d.CreatedAt, &d.DeliveredAt,
+		); err != nil {
+			return nil, err
+		}
+		d.Payload = payload
+		d.Status = entity.WebhookDeliveryStatus(status)
+		deliveries = append(deliveries, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (r *PostgresRepository) BeginTx(ctx context.Context) (db.Tx, error) {
+	if transactional, ok := r.db.(db.Transactional); ok {
+		return transactional.BeginTx(ctx)
+	}
+	return nil, fmt.Errorf("underlying database doesn't support transactions")
+}
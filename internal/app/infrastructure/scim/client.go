@@ -0,0 +1,151 @@
+package scim
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxErrorBytes = 4 << 10
+
+// Client читает пользователей и группы из SCIM 2.0 сервиса (RFC 7644)
+// постранично через /Users и /Groups.
+type Client struct {
+	baseURL    string
+	token      string
+	pageSize   int
+	httpClient *http.Client
+}
+
+type Config struct {
+	BaseURL  string
+	Token    string
+	PageSize int
+	Timeout  time.Duration
+}
+
+func NewClient(cfg Config) *Client {
+	return &Client{
+		baseURL:    strings.TrimRight(cfg.BaseURL, "/"),
+		token:      cfg.Token,
+		pageSize:   cfg.PageSize,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+type listResponse[T any] struct {
+	TotalResults int `json:"totalResults"`
+	StartIndex   int `json:"startIndex"`
+	ItemsPerPage int `json:"itemsPerPage"`
+	Resources    []T `json:"Resources"`
+}
+
+type userResource struct {
+	ID          string `json:"id"`
+	UserName    string `json:"userName"`
+	DisplayName string `json:"displayName"`
+	Name        struct {
+		Formatted string `json:"formatted"`
+	} `json:"name"`
+	// в SCIM active необязателен, отсутствие трактуется как активный пользователь
+	Active *bool `json:"active"`
+}
+
+type groupResource struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Members     []struct {
+		Value string `json:"value"`
+	} `json:"members"`
+}
+
+func (c *Client) Fetch(ctx context.Context) (entity.Directory, error) {
+	users, err := list[userResource](ctx, c, "/Users")
+	if err != nil {
+		return entity.Directory{}, fmt.Errorf("list users: %w", err)
+	}
+	groups, err := list[groupResource](ctx, c, "/Groups")
+	if err != nil {
+		return entity.Directory{}, fmt.Errorf("list groups: %w", err)
+	}
+
+	var dir entity.Directory
+	for _, u := range users {
+		displayName := u.DisplayName
+		if displayName == "" {
+			displayName = u.Name.Formatted
+		}
+		dir.Users = append(dir.Users, entity.DirectoryUser{
+			ID:          u.ID,
+			UserName:    u.UserName,
+			DisplayName: displayName,
+			Active:      u.Active == nil || *u.Active,
+		})
+	}
+	for _, g := range groups {
+		group := entity.DirectoryGroup{ID: g.ID, DisplayName: g.DisplayName}
+		for _, m := range g.Members {
+			group.MemberIDs = append(group.MemberIDs, m.Value)
+		}
+		dir.Groups = append(dir.Groups, group)
+	}
+
+	return dir, nil
+}
+
+func list[T any](ctx context.Context, c *Client, path string) ([]T, error) {
+	var result []T
+	startIndex := 1
+	for {
+		var page listResponse[T]
+		if err := c.get(ctx, path, startIndex, &page); err != nil {
+			return nil, err
+		}
+		result = append(result, page.Resources...)
+
+		// сервер вправе вернуть меньше count записей, поэтому конец списка
+		// определяется по totalResults, а пустая страница защищает от зацикливания
+		startIndex += len(page.Resources)
+		if len(page.Resources) == 0 || startIndex > page.TotalResults {
+			return result, nil
+		}
+	}
+}
+
+func (c *Client) get(ctx context.Context, path string, startIndex int, dst any) error {
+	query := url.Values{}
+	query.Set("startIndex", strconv.Itoa(startIndex))
+	query.Set("count", strconv.Itoa(c.pageSize))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path+"?"+query.Encode(), nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/scim+json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBytes))
+		return fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dst); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package scim_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim/scimtest"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_FetchReadsAllPages(t *testing.T) {
+	server := scimtest.NewServer("secret")
+	defer server.Close()
+	server.Set([]scimtest.User{
+		{ID: "1", UserName: "alice", DisplayName: "Alice", Active: true},
+		{ID: "2", UserName: "bob", DisplayName: "Bob", Active: true},
+		{ID: "3", UserName: "carol", DisplayName: "Carol", Active: false},
+	}, []scimtest.Group{
+		{ID: "g1", DisplayName: "backend", MemberIDs: []string{"1", "3"}},
+		{ID: "g2", DisplayName: "frontend", MemberIDs: []string{"2"}},
+	})
+
+	client := scim.NewClient(scim.Config{BaseURL: server.URL + "/", Token: "secret", PageSize: 2, Timeout: time.Second})
+	dir, err := client.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("fetch: %v", err)
+	}
+
+	want := entity.Directory{
+		Users: []entity.DirectoryUser{
+			{ID: "1", UserName: "alice", DisplayName: "Alice", Active: true},
+			{ID: "2", UserName: "bob", DisplayName: "Bob", Active: true},
+			{ID: "3", UserName: "carol", DisplayName: "Carol", Active: false},
+		},
+		Groups: []entity.DirectoryGroup{
+			{ID: "g1", DisplayName: "backend", MemberIDs: []string{"1", "3"}},
+			{ID: "g2", DisplayName: "frontend", MemberIDs: []string{"2"}},
+		},
+	}
+	if !reflect.DeepEqual(dir, want) {
+		t.Fatalf("unexpected directory: %+v", dir)
+	}
+}
+
+func TestClient_FetchFailsOnWrongToken(t *testing.T) {
+	server := scimtest.NewServer("secret")
+	defer server.Close()
+
+	client := scim.NewClient(scim.Config{BaseURL: server.URL, Token: "wrong", PageSize: 10, Timeout: time.Second})
+	_, err := client.Fetch(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected 401 error, got %v", err)
+	}
+}
@@ -0,0 +1,122 @@
+// Package scimtest содержит фейковый SCIM 2.0 сервер для тестов синхронизации
+// с каталогом.
+package scimtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+)
+
+type User struct {
+	ID          string
+	UserName    string
+	DisplayName string
+	Active      bool
+}
+
+type Group struct {
+	ID          string
+	DisplayName string
+	MemberIDs   []string
+}
+
+// Server отдаёт /Users и /Groups постранично и проверяет bearer-токен.
+// Содержимое каталога можно менять между синхронизациями через Set.
+type Server struct {
+	*httptest.Server
+
+	Token string
+
+	mu     sync.Mutex
+	users  []User
+	groups []Group
+}
+
+func NewServer(token string) *Server {
+	s := &Server{Token: token}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /Users", s.handleUsers)
+	mux.HandleFunc("GET /Groups", s.handleGroups)
+	s.Server = httptest.NewServer(s.authorize(mux))
+	return s
+}
+
+func (s *Server) Set(users []User, groups []Group) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.users = users
+	s.groups = groups
+}
+
+func (s *Server) authorize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.Token != "" && r.Header.Get("Authorization") != "Bearer "+s.Token {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) handleUsers(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	resources := make([]any, len(s.users))
+	for i, u := range s.users {
+		resources[i] = map[string]any{
+			"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:User"},
+			"id":          u.ID,
+			"userName":    u.UserName,
+			"displayName": u.DisplayName,
+			"active":      u.Active,
+		}
+	}
+	s.mu.Unlock()
+
+	writePage(w, r, resources)
+}
+
+func (s *Server) handleGroups(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	resources := make([]any, len(s.groups))
+	for i, g := range s.groups {
+		members := make([]map[string]string, len(g.MemberIDs))
+		for j, id := range g.MemberIDs {
+			members[j] = map[string]string{"value": id}
+		}
+		resources[i] = map[string]any{
+			"schemas":     []string{"urn:ietf:params:scim:schemas:core:2.0:Group"},
+			"id":          g.ID,
+			"displayName": g.DisplayName,
+			"members":     members,
+		}
+	}
+	s.mu.Unlock()
+
+	writePage(w, r, resources)
+}
+
+func writePage(w http.ResponseWriter, r *http.Request, resources []any) {
+	startIndex, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 0 {
+		count = len(resources)
+	}
+
+	from := min(startIndex-1, len(resources))
+	to := min(from+count, len(resources))
+
+	w.Header().Set("Content-Type", "application/scim+json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"schemas":      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+		"totalResults": len(resources),
+		"startIndex":   startIndex,
+		"itemsPerPage": to - from,
+		"Resources":    resources[from:to],
+	})
+}
@@ -0,0 +1,23 @@
+package sink
+
+import (
+	"avito-backend-intern-assignment/internal/app/domain/entity"
+	"context"
+	"log"
+)
+
+// LogSink пишет события outbox в лог сервиса. Полезен как получатель по
+// умолчанию и для отладки.
+type LogSink struct{}
+
+func NewLogSink() *LogSink {
+	return &LogSink{}
+}
+
+func (s *LogSink) Name() string { return "log" }
+
+func (s *LogSink) Deliver(_ context.Context, msg entity.OutboxMessage) error {
+	log.Printf("Event %d: type=%s, aggregate=%s, team=%s, payload=%s",
+		msg.ID, msg.EventType, msg.AggregateID, msg.TeamName, msg.Payload)
+	return nil
+}
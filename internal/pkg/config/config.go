@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -13,6 +14,11 @@ type DbConfig struct {
 	Host     string `env:"DB_HOST"     env-default:"localhost" env-description:"Database host"`
 	Port     string `env:"DB_PORT"     env-default:"5432"     env-description:"Database port"`
 	SSLMode  string `env:"DB_SSLMODE"  env-default:"disable"  env-description:"Database SSL mode"`
+
+	MigrateOnStart     bool          `env:"MIGRATE_ON_START"     env-default:"false" env-description:"Apply embedded migrations before the server starts"`
+	MigrateLockTimeout time.Duration `env:"MIGRATE_LOCK_TIMEOUT" env-default:"5m"    env-description:"How long to wait while another replica holds the migration lock"`
+
+	SlowQueryThreshold time.Duration `env:"DB_SLOW_QUERY_THRESHOLD" env-default:"200ms" env-description:"Queries running at least this long are logged, 0 disables the log"`
 }
 
 func (db DbConfig) URL() string {
@@ -27,9 +33,102 @@ func (db DbConfig) URL() string {
 	)
 }
 
+type OutboxConfig struct {
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" env-default:"1s"  env-description:"Outbox polling interval"`
+	BatchSize    uint64        `env:"OUTBOX_BATCH_SIZE"    env-default:"100" env-description:"Outbox messages dispatched per transaction"`
+	BaseBackoff  time.Duration `env:"OUTBOX_BASE_BACKOFF"  env-default:"1s"  env-description:"Delay before the first redelivery"`
+	MaxBackoff   time.Duration `env:"OUTBOX_MAX_BACKOFF"   env-default:"5m"  env-description:"Upper bound for redelivery delay"`
+}
+
+type WebhookConfig struct {
+	PollInterval time.Duration `env:"WEBHOOK_POLL_INTERVAL" env-default:"1s"  env-description:"Webhook deliveries polling interval"`
+	BatchSize    uint64        `env:"WEBHOOK_BATCH_SIZE"    env-default:"20"  env-description:"Webhook deliveries sent per transaction"`
+	Timeout      time.Duration `env:"WEBHOOK_TIMEOUT"       env-default:"5s"  env-description:"Webhook HTTP request timeout"`
+	MaxAttempts  int           `env:"WEBHOOK_MAX_ATTEMPTS"  env-default:"8"   env-description:"Attempts before a delivery is marked DEAD"`
+	BaseBackoff  time.Duration `env:"WEBHOOK_BASE_BACKOFF"  env-default:"2s"  env-description:"Delay before the first webhook retry"`
+	MaxBackoff   time.Duration `env:"WEBHOOK_MAX_BACKOFF"   env-default:"1h"  env-description:"Upper bound for webhook retry delay"`
+}
+
+type VCSConfig struct {
+	GitHubSecret string `env:"GITHUB_WEBHOOK_SECRET" env-description:"Secret for X-Hub-Signature-256, empty disables /vcs/github"`
+	GitLabToken  string `env:"GITLAB_WEBHOOK_TOKEN"  env-description:"Expected X-Gitlab-Token, empty disables /vcs/gitlab"`
+}
+
+type StreamConfig struct {
+	BufferSize        int           `env:"STREAM_BUFFER_SIZE"        env-default:"64"  env-description:"Events buffered per SSE subscriber before dropping"`
+	HeartbeatInterval time.Duration `env:"STREAM_HEARTBEAT_INTERVAL" env-default:"15s" env-description:"Interval between SSE keep-alive comments"`
+}
+
+type NotifyConfig struct {
+	ChatTimeout  time.Duration `env:"NOTIFY_CHAT_TIMEOUT" env-default:"5s"  env-description:"Chat incoming webhook request timeout"`
+	SMTPHost     string        `env:"SMTP_HOST"           env-description:"SMTP server host, empty disables email notifications"`
+	SMTPPort     string        `env:"SMTP_PORT"           env-default:"25"  env-description:"SMTP server port"`
+	SMTPUsername string        `env:"SMTP_USERNAME"       env-description:"SMTP user, empty disables authentication"`
+	SMTPPassword string        `env:"SMTP_PASSWORD"       env-description:"SMTP password"`
+	SMTPFrom     string        `env:"SMTP_FROM"           env-default:"pr-reviewer@localhost" env-description:"Sender address of notification emails"`
+	SMTPTimeout  time.Duration `env:"SMTP_TIMEOUT"        env-default:"10s" env-description:"Timeout for sending one email"`
+}
+
+type ReviewConfig struct {
+	SLA time.Duration `env:"REVIEW_SLA" env-default:"24h" env-description:"How long a review may wait for a decision when the team has no own SLA"`
+}
+
+type StatsConfig struct {
+	Window            time.Duration `env:"STATS_WINDOW"       env-default:"720h" env-description:"Default statistics window when from is not given"`
+	FairnessTolerance float64       `env:"FAIRNESS_TOLERANCE" env-default:"0.25" env-description:"Allowed relative deviation of a member's review share from the ideal one"`
+}
+
+type DirectoryConfig struct {
+	SCIMURL          string        `env:"SCIM_URL"                    env-description:"Base URL of the SCIM 2.0 directory, empty disables directory sync"`
+	SCIMToken        string        `env:"SCIM_TOKEN"                  env-description:"Bearer token for the SCIM directory"`
+	SCIMPageSize     int           `env:"SCIM_PAGE_SIZE"              env-default:"100"       env-description:"Resources requested per SCIM page"`
+	SCIMTimeout      time.Duration `env:"SCIM_TIMEOUT"                env-default:"10s"       env-description:"Timeout of one SCIM request"`
+	SyncSchedule     string        `env:"DIRECTORY_SYNC_SCHEDULE"     env-default:"0 * * * *" env-description:"Cron schedule of directory sync"`
+	MaxDeactivations int           `env:"DIRECTORY_MAX_DEACTIVATIONS" env-default:"10"        env-description:"Sync deactivating more users is not applied without force, 0 disables the limit"`
+}
+
+type SchedulerConfig struct {
+	Enabled            bool          `env:"SCHEDULER_ENABLED"      env-default:"true"         env-description:"Run digest, reminder and escalation jobs in this process"`
+	DigestSchedule     string        `env:"DIGEST_SCHEDULE"        env-default:"0 9 * * 1-5"  env-description:"Cron schedule of the daily digest, CRON_TZ= prefix is supported"`
+	ReminderSchedule   string        `env:"REMINDER_SCHEDULE"      env-default:"*/30 * * * *" env-description:"Cron schedule of stale review reminders"`
+	ReminderInterval   time.Duration `env:"REMINDER_INTERVAL"      env-default:"24h"          env-description:"Delay between repeated reminders to the same reviewer"`
+	ReminderBatch      uint64        `env:"REMINDER_BATCH_SIZE"    env-default:"500"          env-description:"Reminders sent per scheduler run"`
+	EscalationSchedule string        `env:"ESCALATION_SCHEDULE"    env-default:"*/5 * * * *"  env-description:"Cron schedule of overdue reviewer escalation"`
+	EscalationBatch    uint64        `env:"ESCALATION_BATCH_SIZE"  env-default:"100"          env-description:"Overdue reviewers escalated per scheduler run"`
+}
+
+type IdempotencyConfig struct {
+	TTL             time.Duration `env:"IDEMPOTENCY_TTL"              env-default:"24h"        env-description:"How long responses to requests with Idempotency-Key are kept"`
+	LockTimeout     time.Duration `env:"IDEMPOTENCY_LOCK_TIMEOUT"     env-default:"1m"         env-description:"After this time an unfinished request no longer holds its idempotency key"`
+	CleanupSchedule string        `env:"IDEMPOTENCY_CLEANUP_SCHEDULE" env-default:"15 * * * *" env-description:"Cron schedule of expired idempotency keys removal"`
+}
+
+type TracingConfig struct {
+	Endpoint    string  `env:"OTEL_EXPORTER_OTLP_ENDPOINT" env-description:"OTLP/HTTP collector URL, e.g. http://otel-collector:4318, empty disables tracing"`
+	ServiceName string  `env:"OTEL_SERVICE_NAME"           env-default:"pr-reviewer" env-description:"service.name of exported spans"`
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO"        env-default:"1"           env-description:"Share of traces started by this service that are exported"`
+}
+
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+)
+
 type Config struct {
-	DB         DbConfig `env-prefix:""`
-	ServerPort string   `env:"SERVER_PORT" env-default:"8080" env-description:"HTTP server port"`
+	Storage     string            `env:"STORAGE" env-default:"postgres" env-description:"Storage backend: postgres or memory (demo mode, data is lost on restart)"`
+	DB          DbConfig          `env-prefix:""`
+	Outbox      OutboxConfig      `env-prefix:""`
+	Webhook     WebhookConfig     `env-prefix:""`
+	VCS         VCSConfig         `env-prefix:""`
+	Stream      StreamConfig      `env-prefix:""`
+	Notify      NotifyConfig      `env-prefix:""`
+	Review      ReviewConfig      `env-prefix:""`
+	Scheduler   SchedulerConfig   `env-prefix:""`
+	Stats       StatsConfig       `env-prefix:""`
+	Directory   DirectoryConfig   `env-prefix:""`
+	Idempotency IdempotencyConfig `env-prefix:""`
+	Tracing     TracingConfig     `env-prefix:""`
+	ServerPort  string            `env:"SERVER_PORT" env-default:"8080" env-description:"HTTP server port"`
 }
 
 func FromEnv() (Config, error) {
@@ -37,5 +136,8 @@ func FromEnv() (Config, error) {
 	if err := cleanenv.ReadEnv(&cfg); err != nil {
 		return Config{}, fmt.Errorf("read env: %w", err)
 	}
+	if cfg.Storage != StoragePostgres && cfg.Storage != StorageMemory {
+		return Config{}, fmt.Errorf("unknown STORAGE %q, expected %s or %s", cfg.Storage, StoragePostgres, StorageMemory)
+	}
 	return cfg, nil
 }
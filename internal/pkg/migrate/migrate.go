@@ -0,0 +1,75 @@
+// Package migrate применяет миграции goose к базе сервиса.
+package migrate
+
+import (
+	"avito-backend-intern-assignment/db/migrations"
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// lockRetryPeriod — интервал повторных попыток взять advisory lock.
+const lockRetryPeriod = 5 * time.Second
+
+type Config struct {
+	// FS — каталог миграций, по умолчанию встроенные в бинарник.
+	FS fs.FS
+	// LockTimeout — сколько ждать advisory lock, пока миграции применяет
+	// другая реплика.
+	LockTimeout time.Duration
+}
+
+// NewProvider создаёт goose.Provider поверх пула. Миграции выполняются под
+// сессионной advisory-блокировкой Postgres, поэтому реплики, стартующие
+// одновременно, применяют их по очереди, а опоздавшие видят, что всё уже
+// применено. Provider.Close закрывает только обёртку *sql.DB, пул остаётся открытым.
+func NewProvider(pool *pgxpool.Pool, cfg Config) (*goose.Provider, error) {
+	fsys := cfg.FS
+	if fsys == nil {
+		fsys = migrations.FS
+	}
+
+	attempts := max(uint64(cfg.LockTimeout/lockRetryPeriod), 1)
+	locker, err := lock.NewPostgresSessionLocker(lock.WithLockTimeout(uint64(lockRetryPeriod/time.Second), attempts))
+	if err != nil {
+		return nil, fmt.Errorf("create migration lock: %w", err)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, stdlib.OpenDBFromPool(pool), fsys,
+		goose.WithSessionLocker(locker))
+	if err != nil {
+		return nil, fmt.Errorf("load migrations: %w", err)
+	}
+	return provider, nil
+}
+
+// Up применяет все новые миграции и пишет каждую в лог.
+func Up(ctx context.Context, pool *pgxpool.Pool, cfg Config) error {
+	provider, err := NewProvider(pool, cfg)
+	if err != nil {
+		return err
+	}
+	defer provider.Close()
+
+	results, err := provider.Up(ctx)
+	for _, r := range results {
+		log.Printf("Migrations: %s", r)
+	}
+	if err != nil {
+		return fmt.Errorf("apply migrations: %w", err)
+	}
+
+	version, err := provider.GetDBVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("get schema version: %w", err)
+	}
+	log.Printf("Migrations: schema is at version %d, %d applied now", version, len(results))
+	return nil
+}
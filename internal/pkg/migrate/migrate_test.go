@@ -0,0 +1,69 @@
+package migrate_test
+
+import (
+	"avito-backend-intern-assignment/internal/pkg/migrate"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Провайдер разбирает миграции без подключения к базе, поэтому тест
+// проверяет встраивание и синтаксис goose-аннотаций без Postgres.
+func TestNewProvider_LoadsEmbeddedMigrations(t *testing.T) {
+	pool, err := pgxpool.New(context.Background(), "postgres://postgres@localhost:1/none")
+	if err != nil {
+		t.Fatalf("create pool: %v", err)
+	}
+	defer pool.Close()
+
+	provider, err := migrate.NewProvider(pool, migrate.Config{LockTimeout: time.Minute})
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer provider.Close()
+
+	files, err := filepath.Glob("../../../db/migrations/*.sql")
+	if err != nil {
+		t.Fatalf("glob migrations: %v", err)
+	}
+
+	sources := provider.ListSources()
+	if len(sources) == 0 || len(sources) != len(files) {
+		t.Fatalf("expected %d embedded migrations, got %d", len(files), len(sources))
+	}
+	for i, s := range sources {
+		if filepath.Base(s.Path) != filepath.Base(files[i]) {
+			t.Fatalf("migration %d: expected %s, got %s", i, filepath.Base(files[i]), s.Path)
+		}
+	}
+}
+
+func TestNewProvider_UsesGivenDirectory(t *testing.T) {
+	dir := t.TempDir()
+	migration := "-- +goose Up\nSELECT 1;\n\n-- +goose Down\nSELECT 1;\n"
+	if err := os.WriteFile(filepath.Join(dir, "1_init.sql"), []byte(migration), 0o600); err != nil {
+		t.Fatalf("write migration: %v", err)
+	}
+
+	pool, err := pgxpool.New(context.Background(), "postgres://postgres@localhost:1/none")
+	if err != nil {
+		t.Fatalf("create pool: %v", err)
+	}
+	defer pool.Close()
+
+	provider, err := migrate.NewProvider(pool, migrate.Config{FS: os.DirFS(dir)})
+	if err != nil {
+		t.Fatalf("new provider: %v", err)
+	}
+	defer provider.Close()
+
+	sources := provider.ListSources()
+	if len(sources) != 1 || !strings.HasSuffix(sources[0].Path, "1_init.sql") {
+		t.Fatalf("unexpected sources: %+v", sources)
+	}
+}
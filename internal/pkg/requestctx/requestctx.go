@@ -0,0 +1,51 @@
+// Пакет хранит в context.Context данные о текущем запросе: кто его выполняет
+// и под каким идентификатором он прошёл через API.
+package requestctx
+
+import "context"
+
+const SystemActor = "system"
+
+type (
+	actorKey     struct{}
+	requestIDKey struct{}
+)
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Actor возвращает инициатора операции, для фоновых задач — SystemActor.
+func Actor(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return SystemActor
+}
+
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+type ifMatchKey struct {
+	entityType string
+	entityID   string
+}
+
+// WithIfMatch сохраняет ожидаемую версию сущности из заголовка If-Match.
+// Условие привязано к конкретной сущности, поэтому вложенные операции над
+// другими сущностями его не видят.
+func WithIfMatch(ctx context.Context, entityType string, entityID string, version int64) context.Context {
+	return context.WithValue(ctx, ifMatchKey{entityType: entityType, entityID: entityID}, version)
+}
+
+// IfMatch возвращает ожидаемую версию сущности, если клиент её передал.
+func IfMatch(ctx context.Context, entityType string, entityID string) (int64, bool) {
+	version, ok := ctx.Value(ifMatchKey{entityType: entityType, entityID: entityID}).(int64)
+	return version, ok
+}
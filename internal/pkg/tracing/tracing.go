@@ -0,0 +1,37 @@
+// Package tracing настраивает экспорт OpenTelemetry-спанов в коллектор.
+package tracing
+
+import (
+	"avito-backend-intern-assignment/internal/pkg/config"
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// NewProvider возвращает провайдер, который отправляет спаны по OTLP/HTTP, и
+// функцию, которая досылает накопленные спаны и останавливает экспорт. Без
+// OTEL_EXPORTER_OTLP_ENDPOINT трассировка отключена: спаны не создаются.
+// Остальные OTEL_EXPORTER_OTLP_* (заголовки, TLS, таймаут) экспортёр читает сам.
+func NewProvider(ctx context.Context, cfg config.TracingConfig) (trace.TracerProvider, func(context.Context) error, error) {
+	if cfg.Endpoint == "" {
+		return noop.NewTracerProvider(), func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create otlp exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(cfg.ServiceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	return provider, provider.Shutdown, nil
+}
@@ -0,0 +1,42 @@
+package tracing_test
+
+import (
+	"avito-backend-intern-assignment/internal/pkg/config"
+	"avito-backend-intern-assignment/internal/pkg/tracing"
+	"context"
+	"testing"
+)
+
+func TestNewProvider(t *testing.T) {
+	ctx := context.Background()
+
+	provider, shutdown, err := tracing.NewProvider(ctx, config.TracingConfig{})
+	if err != nil {
+		t.Fatalf("disabled provider: %v", err)
+	}
+	_, span := provider.Tracer("test").Start(ctx, "query")
+	if span.SpanContext().IsValid() {
+		t.Fatal("disabled tracing must not record spans")
+	}
+	if err := shutdown(ctx); err != nil {
+		t.Fatalf("shutdown disabled provider: %v", err)
+	}
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://127.0.0.1:1")
+	provider, shutdown, err = tracing.NewProvider(ctx, config.TracingConfig{
+		Endpoint:    "http://127.0.0.1:1",
+		ServiceName: "pr-reviewer",
+		SampleRatio: 1,
+	})
+	if err != nil {
+		t.Fatalf("otlp provider: %v", err)
+	}
+	_, span = provider.Tracer("test").Start(ctx, "query")
+	if !span.SpanContext().IsSampled() {
+		t.Fatal("expected sampled span")
+	}
+	// спан не экспортирован: Shutdown не ждёт недоступный коллектор
+	if err := shutdown(ctx); err != nil {
+		t.Fatalf("shutdown: %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	// ErrNestedTxNotSupported — транзакция из контекста не умеет открывать
+	// точки сохранения.
+	ErrNestedTxNotSupported = errors.New("db: nested transactions are not supported")
+	// ErrTxOptionsConflict — вложенная транзакция требует изоляции строже, чем
+	// у внешней: поменять её после начала транзакции нельзя.
+	ErrTxOptionsConflict = errors.New("db: nested transaction requires stricter isolation than the outer one")
+)
+
+type txContextKey struct{}
+
+// txScope — транзакция или точка сохранения, начатая WithTx.
+type txScope struct {
+	tx Tx
+	// opts — параметры самой внешней транзакции
+	opts   TxOptions
+	parent *txScope
+
+	mu          sync.Mutex
+	afterCommit []func()
+}
+
+func scopeFrom(ctx context.Context) *txScope {
+	scope, _ := ctx.Value(txContextKey{}).(*txScope)
+	return scope
+}
+
+// TxFromContext возвращает активную транзакцию контекста — ту, в которой
+// выполняется fn из WithTx. Хранилища выполняют в ней запросы, сделанные с
+// этим контекстом, поэтому репозиториям не нужен WithDB(tx).
+func TxFromContext(ctx context.Context) (Tx, bool) {
+	scope := scopeFrom(ctx)
+	if scope == nil {
+		return nil, false
+	}
+	return scope.tx, true
+}
+
+// WithoutTx отвязывает ctx от активной транзакции: запросы и WithTx с ним
+// снова работают вне неё. Нужен, например, фоновым задачам, которые сами
+// управляют своими транзакциями.
+func WithoutTx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, txContextKey{}, (*txScope)(nil))
+}
+
+// AfterCommit вызывает fn, когда будет зафиксирована самая внешняя транзакция
+// ctx, а вне транзакции — сразу. Если транзакция или точка сохранения, в
+// которой зарегистрирован fn, откатится, fn не вызывается. Так побочные
+// эффекты вроде публикации событий в шину не опережают фиксацию данных.
+func AfterCommit(ctx context.Context, fn func()) {
+	scope := scopeFrom(ctx)
+	if scope == nil {
+		fn()
+		return
+	}
+	scope.mu.Lock()
+	scope.afterCommit = append(scope.afterCommit, fn)
+	scope.mu.Unlock()
+}
+
+// committed передаёт отложенные вызовы внешней транзакции или, если это она и
+// есть, выполняет их.
+func (s *txScope) committed() {
+	s.mu.Lock()
+	hooks := s.afterCommit
+	s.afterCommit = nil
+	s.mu.Unlock()
+
+	if s.parent != nil {
+		s.parent.mu.Lock()
+		s.parent.afterCommit = append(s.parent.afterCommit, hooks...)
+		s.parent.mu.Unlock()
+		return
+	}
+	for _, fn := range hooks {
+		fn()
+	}
+}
+
+// withScope начинает транзакцию: верхнего уровня через begin или точку
+// сохранения в активной транзакции ctx. Возвращённый контекст несёт новую
+// транзакцию.
+func withScope(ctx context.Context, opts TxOptions, begin func(ctx context.Context) (Tx, error)) (*txScope, context.Context, error) {
+	parent := scopeFrom(ctx)
+	if parent == nil {
+		tx, err := begin(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		scope := &txScope{tx: tx, opts: opts}
+		return scope, context.WithValue(ctx, txContextKey{}, scope), nil
+	}
+
+	if isolationRank(opts.Isolation) > isolationRank(parent.opts.Isolation) {
+		return nil, nil, fmt.Errorf("%w: %q inside %q", ErrTxOptionsConflict, opts.Isolation, parent.opts.Isolation)
+	}
+	nested, ok := parent.tx.(Transactional)
+	if !ok {
+		return nil, nil, ErrNestedTxNotSupported
+	}
+	tx, err := nested.BeginTx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	scope := &txScope{tx: tx, opts: parent.opts, parent: parent}
+	return scope, context.WithValue(ctx, txContextKey{}, scope), nil
+}
+
+func isolationRank(level IsolationLevel) int {
+	switch level {
+	case RepeatableRead:
+		return 1
+	case Serializable:
+		return 2
+	default:
+		return 0
+	}
+}
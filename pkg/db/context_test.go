@@ -0,0 +1,178 @@
+package db_test
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+)
+
+type counters map[string]int
+
+func (c counters) Clone() memdb.Table { return maps.Clone(c) }
+
+func newStore() *memdb.DB {
+	d := memdb.New()
+	d.Define("counters", counters{})
+	return d
+}
+
+func set(ctx context.Context, t *testing.T, h db.DB, key string, value int) {
+	t.Helper()
+	err := memdb.Write(ctx, h, "counters", func(c counters) error {
+		c[key] = value
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("write %s: %v", key, err)
+	}
+}
+
+func get(ctx context.Context, t *testing.T, h db.DB, key string) (int, bool) {
+	t.Helper()
+	var (
+		value int
+		ok    bool
+	)
+	err := memdb.Read(ctx, h, "counters", func(c counters) error {
+		value, ok = c[key]
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("read %s: %v", key, err)
+	}
+	return value, ok
+}
+
+func TestWithTx_NestedCallsUseSavepoints(t *testing.T) {
+	store := newStore()
+	ctx := context.Background()
+	errBoom := errors.New("boom")
+
+	err := db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+		// запрос через саму базу попадает в транзакцию из контекста
+		set(ctx, t, store, "outer", 1)
+
+		err := db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+			set(ctx, t, store, "outer", 2)
+			set(ctx, t, store, "inner", 1)
+			return errBoom
+		})
+		if !errors.Is(err, errBoom) {
+			t.Fatalf("expected boom from nested tx, got %v", err)
+		}
+		if v, _ := get(ctx, t, store, "outer"); v != 1 {
+			t.Fatalf("savepoint rollback must restore outer=1, got %d", v)
+		}
+
+		return db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+			set(ctx, t, store, "released", 1)
+			return nil
+		})
+	})
+	if err != nil {
+		t.Fatalf("outer tx: %v", err)
+	}
+
+	for key, want := range map[string]int{"outer": 1, "released": 1} {
+		if v, ok := get(ctx, t, store, key); !ok || v != want {
+			t.Fatalf("expected %s=%d after commit, got %d (%v)", key, want, v, ok)
+		}
+	}
+	if _, ok := get(ctx, t, store, "inner"); ok {
+		t.Fatal("write of the rolled back savepoint was committed")
+	}
+}
+
+func TestAfterCommit_WaitsForOutermostCommit(t *testing.T) {
+	store := newStore()
+	ctx := context.Background()
+
+	var calls []string
+	db.AfterCommit(ctx, func() { calls = append(calls, "no tx") })
+
+	err := db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+		_ = db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+			db.AfterCommit(ctx, func() { calls = append(calls, "released") })
+			return nil
+		})
+		_ = db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+			db.AfterCommit(ctx, func() { calls = append(calls, "rolled back") })
+			return errors.New("boom")
+		})
+		if len(calls) != 1 {
+			t.Fatalf("hooks must wait for the outer commit, got %v", calls)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("outer tx: %v", err)
+	}
+
+	if want := []string{"no tx", "released"}; !slices.Equal(calls, want) {
+		t.Fatalf("expected %v, got %v", want, calls)
+	}
+
+	calls = nil
+	_ = db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+		db.AfterCommit(ctx, func() { calls = append(calls, "outer rolled back") })
+		return errors.New("boom")
+	})
+	if len(calls) != 0 {
+		t.Fatalf("hooks of a rolled back tx must not run, got %v", calls)
+	}
+}
+
+func TestWithTxOptions_NestedIsolation(t *testing.T) {
+	store := newStore()
+	ctx := context.Background()
+	serializable := db.TxOptions{Isolation: db.Serializable}
+	noop := func(context.Context, db.Tx) error { return nil }
+
+	err := db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+		return db.WithTxOptions(ctx, store, serializable, noop)
+	})
+	if !errors.Is(err, db.ErrTxOptionsConflict) {
+		t.Fatalf("expected ErrTxOptionsConflict, got %v", err)
+	}
+
+	var attempts int
+	err = db.WithTxOptions(ctx, store, serializable, func(ctx context.Context, _ db.Tx) error {
+		// вложенная транзакция не повторяется: повторить можно только внешнюю
+		err := db.WithTxRetry(ctx, store, serializable, fastRetry, func(context.Context, db.Tx) error {
+			attempts++
+			return db.ErrSerialization
+		})
+		if !errors.Is(err, db.ErrSerialization) {
+			t.Fatalf("expected ErrSerialization from nested retry, got %v", err)
+		}
+		return db.WithTx(ctx, store, noop)
+	})
+	if err != nil || attempts != 1 {
+		t.Fatalf("expected a single nested attempt, got %d attempts, %v", attempts, err)
+	}
+}
+
+func TestWithoutTx_DetachesContext(t *testing.T) {
+	store := newStore()
+	ctx := context.Background()
+
+	err := db.WithTx(ctx, store, func(ctx context.Context, _ db.Tx) error {
+		set(ctx, t, store, "a", 1)
+
+		detached := db.WithoutTx(ctx)
+		if _, ok := db.TxFromContext(detached); ok {
+			t.Fatal("detached context still carries the tx")
+		}
+		if _, ok := get(detached, t, store, "a"); ok {
+			t.Fatal("detached read must not see uncommitted write")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("tx: %v", err)
+	}
+}
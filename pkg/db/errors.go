@@ -0,0 +1,53 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Ошибки хранилища, не зависящие от драйвера. Адаптеры переводят в них
+// ошибки своей базы, а репозитории и сервисы проверяют их через errors.Is.
+var (
+	// ErrNotFound — запрос одной строки ничего не вернул.
+	ErrNotFound = errors.New("db: not found")
+	// ErrConflict — нарушено ограничение уникальности.
+	ErrConflict = errors.New("db: unique constraint violation")
+	// ErrForeignKey — строка ссылается на несуществующую запись.
+	ErrForeignKey = errors.New("db: foreign key violation")
+	// ErrSerialization — транзакция прервана конфликтом сериализации или
+	// взаимной блокировкой; её можно повторить целиком (см. WithTxRetry).
+	ErrSerialization = errors.New("db: serialization failure")
+)
+
+// ConstraintError — нарушение ограничения с указанием таблицы и имени
+// ограничения, чтобы сервис мог отличить, например, повтор первичного ключа
+// от повтора во вложенной таблице. errors.Is находит в ней и Kind, и Err.
+type ConstraintError struct {
+	// Kind — ErrConflict или ErrForeignKey.
+	Kind       error
+	Table      string
+	Constraint string
+	// Err — исходная ошибка драйвера, может быть nil.
+	Err error
+}
+
+func (e *ConstraintError) Error() string {
+	msg := fmt.Sprintf("%v: %s.%s", e.Kind, e.Table, e.Constraint)
+	if e.Err != nil {
+		msg += ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *ConstraintError) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+	return []error{e.Kind, e.Err}
+}
+
+// IsConstraint сообщает, нарушено ли ограничение constraint с типом kind.
+func IsConstraint(err error, kind error, constraint string) bool {
+	var ce *ConstraintError
+	return errors.As(err, &ce) && errors.Is(ce.Kind, kind) && ce.Constraint == constraint
+}
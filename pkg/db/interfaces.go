@@ -46,17 +46,26 @@ type Tx interface {
 
 type TransactionalRepository[T any] interface {
 	// WithDB предполагает создание копии репозитория с заменой внутреннего DB на другой DB.
-	// Предлагается использовать метод для подмены на tx при совместном использовании
-	// с db.WithTx(...), если необходима транзакционность
+	// Внутри db.WithTx(...) это не обязательно: хранилище само выполняет запросы
+	// в транзакции из контекста, но явная подмена на tx по-прежнему работает
 	WithDB(db DB) T
 	Transactional
 }
 
+// WithTx выполняет fn в транзакции и фиксирует её, если fn вернула nil.
+// Контекст fn несёт транзакцию (см. TxFromContext); если транзакция уже есть
+// в ctx, WithTx открывает в ней точку сохранения, и ошибка fn откатывает
+// только изменения fn.
 func WithTx(ctx context.Context, repo Transactional, fn func(ctx context.Context, tx Tx) error) error {
-	tx, err := repo.BeginTx(ctx)
+	return runTx(ctx, TxOptions{}, repo.BeginTx, fn)
+}
+
+func runTx(ctx context.Context, opts TxOptions, begin func(ctx context.Context) (Tx, error), fn func(ctx context.Context, tx Tx) error) error {
+	scope, txCtx, err := withScope(ctx, opts, begin)
 	if err != nil {
 		return err
 	}
+	tx := scope.tx
 
 	defer func() {
 		if p := recover(); p != nil {
@@ -65,10 +74,14 @@ func WithTx(ctx context.Context, repo Transactional, fn func(ctx context.Context
 		}
 	}()
 
-	if err := fn(ctx, tx); err != nil {
+	if err := fn(txCtx, tx); err != nil {
 		_ = tx.Rollback(ctx)
 		return err
 	}
 
-	return tx.Commit(ctx)
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+	scope.committed()
+	return nil
 }
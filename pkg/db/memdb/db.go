@@ -0,0 +1,223 @@
+// Package memdb — хранилище в памяти с транзакциями для тестов и демо-режима
+// без Postgres.
+//
+// Данные лежат в именованных таблицах. Зафиксированная таблица никогда не
+// меняется на месте: запись клонирует её (copy-on-write), изменения видны
+// только своей транзакции и публикуются атомарно при Commit, а Rollback просто
+// отбрасывает копии. Пишущие транзакции сериализуются, читатели не
+// блокируются и видят последнее зафиксированное состояние (как READ COMMITTED).
+// Точка сохранения (Tx.BeginTx) запоминает копии уже изменённых транзакцией
+// таблиц и при откате возвращает их.
+package memdb
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+var (
+	ErrSQLNotSupported = errors.New("memdb: sql queries are not supported")
+	ErrTxClosed        = errors.New("memdb: transaction is already closed")
+	ErrTxReadOnly      = errors.New("memdb: transaction is read-only")
+)
+
+// Table — содержимое одной таблицы. Clone должен возвращать глубокую копию,
+// иначе транзакция испортит зафиксированные данные.
+type Table interface {
+	Clone() Table
+}
+
+type DB struct {
+	mu     sync.RWMutex
+	tables map[string]Table
+
+	// writer — семафор пишущей транзакции; канал, а не мьютекс, чтобы
+	// ожидание прерывалось отменой контекста
+	writer chan struct{}
+}
+
+var _ db.TransactionalDB = (*DB)(nil)
+
+func New() *DB {
+	return &DB{
+		tables: make(map[string]Table),
+		writer: make(chan struct{}, 1),
+	}
+}
+
+// Define регистрирует пустую таблицу name, если её ещё нет.
+func (d *DB) Define(name string, empty Table) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, ok := d.tables[name]; !ok {
+		d.tables[name] = empty
+	}
+}
+
+func (d *DB) committed(name string) (Table, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	t, ok := d.tables[name]
+	if !ok {
+		return nil, fmt.Errorf("memdb: table %q is not defined", name)
+	}
+	return t, nil
+}
+
+func (d *DB) publish(tables map[string]Table) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for name, t := range tables {
+		d.tables[name] = t
+	}
+}
+
+func (d *DB) lockWriter(ctx context.Context) error {
+	select {
+	case d.writer <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (d *DB) unlockWriter() {
+	<-d.writer
+}
+
+func (d *DB) BeginTx(_ context.Context) (db.Tx, error) {
+	return &Tx{db: d}, nil
+}
+
+// BeginTxWithOptions начинает транзакцию с уровнем изоляции выше READ
+// COMMITTED, сразу захватывая семафор писателя: такие транзакции выполняются
+// строго по очереди и никогда не получают db.ErrSerialization.
+func (d *DB) BeginTxWithOptions(ctx context.Context, opts db.TxOptions) (db.Tx, error) {
+	t := &Tx{db: d, readOnly: opts.ReadOnly}
+	switch opts.Isolation {
+	case db.IsolationDefault, db.ReadCommitted:
+	case db.RepeatableRead, db.Serializable:
+		if err := d.lockWriter(ctx); err != nil {
+			return nil, err
+		}
+		t.locked = true
+		t.dirty = make(map[string]Table)
+	default:
+		return nil, fmt.Errorf("memdb: unknown isolation level %q", opts.Isolation)
+	}
+	return t, nil
+}
+
+func (d *DB) read(_ context.Context, name string) (Table, error) {
+	return d.committed(name)
+}
+
+// write вне транзакции работает как оператор с автокоммитом.
+func (d *DB) write(ctx context.Context, name string, fn func(Table) error) error {
+	if err := d.lockWriter(ctx); err != nil {
+		return err
+	}
+	defer d.unlockWriter()
+
+	t, err := d.committed(name)
+	if err != nil {
+		return err
+	}
+	t = t.Clone()
+	if err := fn(t); err != nil {
+		return err
+	}
+
+	d.publish(map[string]Table{name: t})
+	return nil
+}
+
+func (d *DB) Exec(context.Context, string, ...any) error {
+	return ErrSQLNotSupported
+}
+
+func (d *DB) Query(context.Context, string, ...any) (db.Rows, error) {
+	return nil, ErrSQLNotSupported
+}
+
+func (d *DB) QueryRow(context.Context, string, ...any) db.Row {
+	return errRow{err: ErrSQLNotSupported}
+}
+
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(...any) error { return r.err }
+
+// handle — то, что репозиторий получает через WithDB: сам DB или его Tx.
+type handle interface {
+	read(ctx context.Context, name string) (Table, error)
+	write(ctx context.Context, name string, fn func(Table) error) error
+}
+
+func resolve(ctx context.Context, h db.DB) (handle, error) {
+	// как и драйвер Postgres, не выполняем запрос с отменённым контекстом
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// репозитории на DB работают в транзакции из контекста, как и на пуле Postgres
+	if d, ok := h.(*DB); ok {
+		if tx, ok := d.txFrom(ctx); ok {
+			return tx, nil
+		}
+	}
+	hh, ok := h.(handle)
+	if !ok {
+		return nil, fmt.Errorf("memdb: %T is not a memdb handle", h)
+	}
+	return hh, nil
+}
+
+// txFrom возвращает транзакцию этой базы из ctx.
+func (d *DB) txFrom(ctx context.Context) (handle, bool) {
+	tx, ok := db.TxFromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	switch t := tx.(type) {
+	case *Tx:
+		return t, t.db == d
+	case *savepoint:
+		return t, t.tx.db == d
+	}
+	return nil, false
+}
+
+// Read вызывает fn с таблицей name в том виде, в каком её видит h.
+// fn не должна менять таблицу.
+func Read[T Table](ctx context.Context, h db.DB, name string, fn func(T) error) error {
+	hh, err := resolve(ctx, h)
+	if err != nil {
+		return err
+	}
+	t, err := hh.read(ctx, name)
+	if err != nil {
+		return err
+	}
+	return fn(t.(T))
+}
+
+// Write вызывает fn с изменяемой копией таблицы name. Вне транзакции копия
+// публикуется, только если fn вернула nil; в транзакции ошибка fn, как и в
+// Postgres, требует отката всей транзакции или точки сохранения.
+func Write[T Table](ctx context.Context, h db.DB, name string, fn func(T) error) error {
+	hh, err := resolve(ctx, h)
+	if err != nil {
+		return err
+	}
+	return hh.write(ctx, name, func(t Table) error {
+		return fn(t.(T))
+	})
+}
@@ -0,0 +1,225 @@
+package memdb_test
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+)
+
+type counters map[string]int
+
+func (c counters) Clone() memdb.Table { return maps.Clone(c) }
+
+func newDB() *memdb.DB {
+	d := memdb.New()
+	d.Define("counters", counters{})
+	return d
+}
+
+func set(t *testing.T, h db.DB, key string, value int) {
+	t.Helper()
+	err := memdb.Write(context.Background(), h, "counters", func(c counters) error {
+		c[key] = value
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("write %s: %v", key, err)
+	}
+}
+
+func get(t *testing.T, h db.DB, key string) (int, bool) {
+	t.Helper()
+	var (
+		value int
+		ok    bool
+	)
+	err := memdb.Read(context.Background(), h, "counters", func(c counters) error {
+		value, ok = c[key]
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("read %s: %v", key, err)
+	}
+	return value, ok
+}
+
+func TestTx_CommitPublishesChanges(t *testing.T) {
+	d := newDB()
+	ctx := context.Background()
+
+	tx, err := d.BeginTx(ctx)
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	set(t, tx, "a", 1)
+
+	if v, _ := get(t, tx, "a"); v != 1 {
+		t.Fatalf("tx must see its own write, got %d", v)
+	}
+	if _, ok := get(t, d, "a"); ok {
+		t.Fatal("uncommitted write is visible outside the tx")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+	if v, _ := get(t, d, "a"); v != 1 {
+		t.Fatalf("expected committed value 1, got %d", v)
+	}
+}
+
+func TestWithTx_RollbackDiscardsChanges(t *testing.T) {
+	d := newDB()
+	set(t, d, "a", 1)
+
+	errBoom := errors.New("boom")
+	err := db.WithTx(context.Background(), d, func(ctx context.Context, tx db.Tx) error {
+		set(t, tx, "a", 2)
+		set(t, tx, "b", 3)
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+
+	if v, _ := get(t, d, "a"); v != 1 {
+		t.Fatalf("rollback must restore a=1, got %d", v)
+	}
+	if _, ok := get(t, d, "b"); ok {
+		t.Fatal("rollback must discard b")
+	}
+}
+
+func TestTx_ClosedTxRejectsUse(t *testing.T) {
+	d := newDB()
+	ctx := context.Background()
+
+	tx, _ := d.BeginTx(ctx)
+	if err := tx.Rollback(ctx); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+
+	if err := tx.Commit(ctx); !errors.Is(err, memdb.ErrTxClosed) {
+		t.Fatalf("expected ErrTxClosed on commit, got %v", err)
+	}
+	err := memdb.Write(ctx, tx, "counters", func(counters) error { return nil })
+	if !errors.Is(err, memdb.ErrTxClosed) {
+		t.Fatalf("expected ErrTxClosed on write, got %v", err)
+	}
+}
+
+func TestTx_WritersAreSerialized(t *testing.T) {
+	d := newDB()
+	ctx := context.Background()
+
+	first, _ := d.BeginTx(ctx)
+	set(t, first, "a", 1)
+
+	// второй писатель ждёт первого и сдаётся по таймауту контекста
+	second, _ := d.BeginTx(ctx)
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	err := memdb.Write(waitCtx, second, "counters", func(c counters) error {
+		c["a"] = 2
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected second writer to wait, got %v", err)
+	}
+	_ = second.Rollback(ctx)
+
+	// читатели не блокируются и видят зафиксированное состояние
+	if _, ok := get(t, d, "a"); ok {
+		t.Fatal("reader must not see uncommitted write")
+	}
+
+	if err := first.Commit(ctx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	third, _ := d.BeginTx(ctx)
+	set(t, third, "a", 3)
+	if err := third.Commit(ctx); err != nil {
+		t.Fatalf("commit after first released the writer lock: %v", err)
+	}
+	if v, _ := get(t, d, "a"); v != 3 {
+		t.Fatalf("expected a=3, got %d", v)
+	}
+}
+
+func TestTx_ReadOnlyRejectsWrites(t *testing.T) {
+	d := newDB()
+	set(t, d, "a", 1)
+	ctx := context.Background()
+
+	tx, err := d.BeginTxWithOptions(ctx, db.TxOptions{ReadOnly: true})
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if v, _ := get(t, tx, "a"); v != 1 {
+		t.Fatalf("read-only tx must read committed data, got %d", v)
+	}
+	err = memdb.Write(ctx, tx, "counters", func(c counters) error {
+		c["a"] = 2
+		return nil
+	})
+	if !errors.Is(err, memdb.ErrTxReadOnly) {
+		t.Fatalf("expected ErrTxReadOnly, got %v", err)
+	}
+}
+
+func TestTx_SerializableLocksWriterAtBegin(t *testing.T) {
+	d := newDB()
+	ctx := context.Background()
+
+	first, err := d.BeginTxWithOptions(ctx, db.TxOptions{Isolation: db.Serializable})
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+
+	// вторая сериализуемая транзакция ждёт первую ещё до чтения
+	waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := d.BeginTxWithOptions(waitCtx, db.TxOptions{Isolation: db.Serializable}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected second serializable tx to wait, got %v", err)
+	}
+
+	set(t, first, "a", 1)
+	if err := first.Commit(ctx); err != nil {
+		t.Fatalf("commit: %v", err)
+	}
+
+	second, err := d.BeginTxWithOptions(ctx, db.TxOptions{Isolation: db.Serializable})
+	if err != nil {
+		t.Fatalf("begin after commit: %v", err)
+	}
+	if v, _ := get(t, second, "a"); v != 1 {
+		t.Fatalf("expected a=1, got %d", v)
+	}
+	if err := second.Rollback(ctx); err != nil {
+		t.Fatalf("rollback: %v", err)
+	}
+
+	if _, err := d.BeginTxWithOptions(ctx, db.TxOptions{Isolation: "snapshot"}); err == nil {
+		t.Fatal("expected error for unknown isolation level")
+	}
+}
+
+func TestDB_SQLIsNotSupported(t *testing.T) {
+	d := newDB()
+	ctx := context.Background()
+
+	if err := d.Exec(ctx, "SELECT 1"); !errors.Is(err, memdb.ErrSQLNotSupported) {
+		t.Fatalf("expected ErrSQLNotSupported, got %v", err)
+	}
+	var one int
+	if err := d.QueryRow(ctx, "SELECT 1").Scan(&one); !errors.Is(err, memdb.ErrSQLNotSupported) {
+		t.Fatalf("expected ErrSQLNotSupported, got %v", err)
+	}
+}
@@ -0,0 +1,215 @@
+package memdb
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"context"
+	"sync"
+)
+
+// Tx читает зафиксированные таблицы, пока не начнёт писать. Первая запись
+// захватывает семафор писателя до Commit или Rollback; изменённые таблицы
+// хранятся в dirty.
+type Tx struct {
+	db       *DB
+	readOnly bool
+
+	mu     sync.Mutex
+	dirty  map[string]Table
+	locked bool
+	closed bool
+}
+
+var _ db.Tx = (*Tx)(nil)
+
+func (t *Tx) read(_ context.Context, name string) (Table, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return nil, ErrTxClosed
+	}
+	if table, ok := t.dirty[name]; ok {
+		return table, nil
+	}
+	return t.db.committed(name)
+}
+
+func (t *Tx) write(ctx context.Context, name string, fn func(Table) error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return ErrTxClosed
+	}
+	if t.readOnly {
+		return ErrTxReadOnly
+	}
+	if !t.locked {
+		if err := t.db.lockWriter(ctx); err != nil {
+			return err
+		}
+		t.locked = true
+		t.dirty = make(map[string]Table)
+	}
+
+	table, ok := t.dirty[name]
+	if !ok {
+		committed, err := t.db.committed(name)
+		if err != nil {
+			return err
+		}
+		table = committed.Clone()
+		t.dirty[name] = table
+	}
+	return fn(table)
+}
+
+func (t *Tx) Commit(_ context.Context) error {
+	return t.finish(true)
+}
+
+func (t *Tx) Rollback(_ context.Context) error {
+	return t.finish(false)
+}
+
+func (t *Tx) finish(commit bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return ErrTxClosed
+	}
+	t.closed = true
+
+	if !t.locked {
+		return nil
+	}
+	if commit {
+		t.db.publish(t.dirty)
+	}
+	t.dirty = nil
+	t.db.unlockWriter()
+	return nil
+}
+
+// BeginTx открывает точку сохранения в t.
+func (t *Tx) BeginTx(_ context.Context) (db.Tx, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return nil, ErrTxClosed
+	}
+	snapshot := make(map[string]Table, len(t.dirty))
+	for name, table := range t.dirty {
+		snapshot[name] = table.Clone()
+	}
+	return &savepoint{tx: t, snapshot: snapshot}, nil
+}
+
+// restore возвращает изменённые таблицы к snapshot. Захваченный семафор
+// писателя остаётся у транзакции до её конца.
+func (t *Tx) restore(snapshot map[string]Table) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return ErrTxClosed
+	}
+	if t.locked {
+		t.dirty = snapshot
+	}
+	return nil
+}
+
+func (t *Tx) Exec(context.Context, string, ...any) error {
+	return ErrSQLNotSupported
+}
+
+func (t *Tx) Query(context.Context, string, ...any) (db.Rows, error) {
+	return nil, ErrSQLNotSupported
+}
+
+func (t *Tx) QueryRow(context.Context, string, ...any) db.Row {
+	return errRow{err: ErrSQLNotSupported}
+}
+
+// savepoint читает и пишет таблицы своей транзакции.
+type savepoint struct {
+	tx *Tx
+
+	mu       sync.Mutex
+	snapshot map[string]Table
+	closed   bool
+}
+
+var _ db.Tx = (*savepoint)(nil)
+
+func (s *savepoint) read(ctx context.Context, name string) (Table, error) {
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+	return s.tx.read(ctx, name)
+}
+
+func (s *savepoint) write(ctx context.Context, name string, fn func(Table) error) error {
+	if err := s.check(); err != nil {
+		return err
+	}
+	return s.tx.write(ctx, name, fn)
+}
+
+func (s *savepoint) check() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return ErrTxClosed
+	}
+	return nil
+}
+
+func (s *savepoint) BeginTx(ctx context.Context) (db.Tx, error) {
+	if err := s.check(); err != nil {
+		return nil, err
+	}
+	return s.tx.BeginTx(ctx)
+}
+
+func (s *savepoint) Commit(_ context.Context) error {
+	_, err := s.finish()
+	return err
+}
+
+func (s *savepoint) Rollback(_ context.Context) error {
+	snapshot, err := s.finish()
+	if err != nil {
+		return err
+	}
+	return s.tx.restore(snapshot)
+}
+
+func (s *savepoint) finish() (map[string]Table, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil, ErrTxClosed
+	}
+	s.closed = true
+	snapshot := s.snapshot
+	s.snapshot = nil
+	return snapshot, nil
+}
+
+func (s *savepoint) Exec(context.Context, string, ...any) error {
+	return ErrSQLNotSupported
+}
+
+func (s *savepoint) Query(context.Context, string, ...any) (db.Rows, error) {
+	return nil, ErrSQLNotSupported
+}
+
+func (s *savepoint) QueryRow(context.Context, string, ...any) db.Row {
+	return errRow{err: ErrSQLNotSupported}
+}
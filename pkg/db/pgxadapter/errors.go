@@ -0,0 +1,44 @@
+package pgxadapter
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// SQLSTATE-коды нарушений ограничений и прерванных транзакций.
+const (
+	uniqueViolation      = "23505"
+	foreignKeyViolation  = "23503"
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+// translate переводит ошибки pgx в ошибки пакета db, сохраняя исходную в
+// цепочке. Это единственное место, где репозитории зависят от кодов Postgres.
+func translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%w: %w", db.ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return &db.ConstraintError{Kind: db.ErrConflict, Table: pgErr.TableName, Constraint: pgErr.ConstraintName, Err: err}
+		case foreignKeyViolation:
+			return &db.ConstraintError{Kind: db.ErrForeignKey, Table: pgErr.TableName, Constraint: pgErr.ConstraintName, Err: err}
+		case serializationFailure, deadlockDetected:
+			return fmt.Errorf("%w: %w", db.ErrSerialization, err)
+		}
+	}
+
+	return err
+}
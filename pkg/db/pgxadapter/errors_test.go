@@ -0,0 +1,70 @@
+package pgxadapter_test
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/pgxadapter"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type fakeRow struct {
+	err error
+}
+
+func (r fakeRow) Scan(...any) error { return r.err }
+
+func TestRowScan_TranslatesErrors(t *testing.T) {
+	unique := &pgconn.PgError{Code: "23505", TableName: "pullrequests", ConstraintName: "pullrequests_pkey"}
+	foreign := &pgconn.PgError{Code: "23503", TableName: "users", ConstraintName: "users_team_name_fkey"}
+	other := &pgconn.PgError{Code: "42P01"}
+	serialization := &pgconn.PgError{Code: "40001"}
+	deadlock := &pgconn.PgError{Code: "40P01"}
+
+	tests := []struct {
+		name     string
+		err      error
+		wantIs   []error
+		wantNot  []error
+		wantCons string
+	}{
+		{name: "no rows", err: pgx.ErrNoRows, wantIs: []error{db.ErrNotFound, pgx.ErrNoRows}},
+		{name: "unique violation", err: unique, wantIs: []error{db.ErrConflict, unique}, wantNot: []error{db.ErrNotFound}, wantCons: "pullrequests_pkey"},
+		{name: "foreign key violation", err: foreign, wantIs: []error{db.ErrForeignKey, foreign}, wantCons: "users_team_name_fkey"},
+		{name: "serialization failure", err: serialization, wantIs: []error{db.ErrSerialization, serialization}, wantNot: []error{db.ErrConflict}},
+		{name: "deadlock", err: deadlock, wantIs: []error{db.ErrSerialization, deadlock}},
+		{name: "other postgres error", err: other, wantIs: []error{other}, wantNot: []error{db.ErrNotFound, db.ErrConflict, db.ErrForeignKey, db.ErrSerialization}},
+		{name: "connection failure", err: context.DeadlineExceeded, wantIs: []error{context.DeadlineExceeded}, wantNot: []error{db.ErrNotFound}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pgxadapter.NewRow(fakeRow{err: tt.err}).Scan()
+
+			for _, target := range tt.wantIs {
+				if !errors.Is(err, target) {
+					t.Errorf("expected %v to match %v", err, target)
+				}
+			}
+			for _, target := range tt.wantNot {
+				if errors.Is(err, target) {
+					t.Errorf("expected %v not to match %v", err, target)
+				}
+			}
+
+			var ce *db.ConstraintError
+			if tt.wantCons != "" && (!errors.As(err, &ce) || ce.Constraint != tt.wantCons) {
+				t.Errorf("expected constraint %s, got %v", tt.wantCons, err)
+			}
+		})
+	}
+}
+
+func TestRowScan_NilStaysNil(t *testing.T) {
+	if err := pgxadapter.NewRow(fakeRow{}).Scan(); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+}
@@ -3,7 +3,10 @@ package pgxadapter
 import (
 	"avito-backend-intern-assignment/pkg/db"
 	"context"
+	"fmt"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -12,18 +15,18 @@ type PoolAdapter struct {
 }
 
 func (p *PoolAdapter) Exec(ctx context.Context, sql string, args ...any) error {
-	_, err := p.Pool.Exec(ctx, sql, args...)
-	return err
+	_, err := p.conn(ctx).Exec(ctx, sql, args...)
+	return translate(err)
 }
 
 func (p *PoolAdapter) QueryRow(ctx context.Context, sql string, args ...any) db.Row {
-	return &row{pgxRow: p.Pool.QueryRow(ctx, sql, args...)}
+	return &row{pgxRow: p.conn(ctx).QueryRow(ctx, sql, args...)}
 }
 
 func (p *PoolAdapter) Query(ctx context.Context, sql string, args ...any) (db.Rows, error) {
-	pgxRows, err := p.Pool.Query(ctx, sql, args...)
+	pgxRows, err := p.conn(ctx).Query(ctx, sql, args...)
 	if err != nil {
-		return nil, err
+		return nil, translate(err)
 	}
 
 	return &rows{
@@ -31,10 +34,62 @@ func (p *PoolAdapter) Query(ctx context.Context, sql string, args ...any) (db.Ro
 	}, nil
 }
 
+// conn — общее у пула и pgx.Tx.
+type conn interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// conn возвращает транзакцию этого пула из ctx, если она есть: так репозитории
+// на пуле работают внутри db.WithTx без WithDB(tx).
+func (p *PoolAdapter) conn(ctx context.Context) conn {
+	if t, ok := db.TxFromContext(ctx); ok {
+		if t, ok := t.(*tx); ok && t.pool == p.Pool {
+			return t.tx
+		}
+	}
+	return p.Pool
+}
+
+// BeginTx всегда начинает новую транзакцию; вложенные транзакции открывает
+// db.WithTx.
 func (p *PoolAdapter) BeginTx(ctx context.Context) (db.Tx, error) {
-	pgxTx, err := p.Pool.Begin(ctx)
+	return p.BeginTxWithOptions(ctx, db.TxOptions{})
+}
+
+func (p *PoolAdapter) BeginTxWithOptions(ctx context.Context, opts db.TxOptions) (db.Tx, error) {
+	pgxOpts, err := toPgxTxOptions(opts)
 	if err != nil {
 		return nil, err
 	}
-	return &tx{tx: pgxTx}, nil
+
+	pgxTx, err := p.Pool.BeginTx(ctx, pgxOpts)
+	if err != nil {
+		return nil, translate(err)
+	}
+	return &tx{tx: pgxTx, pool: p.Pool}, nil
+}
+
+var isolationLevels = map[db.IsolationLevel]pgx.TxIsoLevel{
+	db.IsolationDefault: "",
+	db.ReadCommitted:    pgx.ReadCommitted,
+	db.RepeatableRead:   pgx.RepeatableRead,
+	db.Serializable:     pgx.Serializable,
+}
+
+func toPgxTxOptions(opts db.TxOptions) (pgx.TxOptions, error) {
+	isoLevel, ok := isolationLevels[opts.Isolation]
+	if !ok {
+		return pgx.TxOptions{}, fmt.Errorf("unknown isolation level %q", opts.Isolation)
+	}
+
+	pgxOpts := pgx.TxOptions{IsoLevel: isoLevel}
+	if opts.ReadOnly {
+		pgxOpts.AccessMode = pgx.ReadOnly
+	}
+	if opts.Deferrable {
+		pgxOpts.DeferrableMode = pgx.Deferrable
+	}
+	return pgxOpts, nil
 }
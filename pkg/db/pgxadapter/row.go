@@ -13,5 +13,5 @@ func NewRow(pgxRow pgx.Row) *row {
 }
 
 func (r *row) Scan(dest ...any) error {
-	return r.pgxRow.Scan(dest...)
+	return translate(r.pgxRow.Scan(dest...))
 }
@@ -13,6 +13,6 @@ func NewRows(pgxRows pgx.Rows) *rows {
 }
 
 func (r *rows) Next() bool             { return r.pgxRows.Next() }
-func (r *rows) Scan(dest ...any) error { return r.pgxRows.Scan(dest...) }
+func (r *rows) Scan(dest ...any) error { return translate(r.pgxRows.Scan(dest...)) }
 func (r *rows) Close()                 { r.pgxRows.Close() }
-func (r *rows) Err() error             { return r.pgxRows.Err() }
+func (r *rows) Err() error             { return translate(r.pgxRows.Err()) }
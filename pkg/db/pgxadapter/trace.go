@@ -0,0 +1,128 @@
+package pgxadapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "avito-backend-intern-assignment/pkg/db/pgxadapter"
+
+type TracingConfig struct {
+	// SlowQueryThreshold — запросы не быстрее порога пишутся в лог; 0
+	// отключает лог медленных запросов.
+	SlowQueryThreshold time.Duration
+	// TracerProvider по умолчанию — глобальный otel.GetTracerProvider().
+	TracerProvider trace.TracerProvider
+}
+
+// QueryTracer подключается к пулу через pgxpool.Config.ConnConfig.Tracer и
+// видит все запросы PoolAdapter и его транзакций: на каждый создаёт
+// OpenTelemetry-спан с текстом SQL, числом затронутых строк и ошибкой, а
+// медленные пишет в лог. Аргументы запросов не записываются — в них бывают
+// персональные данные.
+type QueryTracer struct {
+	tracer        trace.Tracer
+	slowThreshold time.Duration
+}
+
+var _ pgx.QueryTracer = (*QueryTracer)(nil)
+
+func NewQueryTracer(cfg TracingConfig) *QueryTracer {
+	provider := cfg.TracerProvider
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	return &QueryTracer{
+		tracer:        provider.Tracer(tracerName),
+		slowThreshold: cfg.SlowQueryThreshold,
+	}
+}
+
+// NewPool открывает пул, запросы которого проходят через QueryTracer.
+func NewPool(ctx context.Context, connString string, cfg TracingConfig) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, fmt.Errorf("parse db config: %w", err)
+	}
+	poolCfg.ConnConfig.Tracer = NewQueryTracer(cfg)
+	return pgxpool.NewWithConfig(ctx, poolCfg)
+}
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	sql   string
+	start time.Time
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	sql := compactSQL(data.SQL)
+	operation := operationName(sql)
+	ctx, _ = t.tracer.Start(ctx, operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBOperationName(operation),
+			semconv.DBQueryText(sql),
+		),
+	)
+	return context.WithValue(ctx, queryStartKey{}, queryStart{sql: sql, start: time.Now()})
+}
+
+// TraceQueryEnd для Query вызывается при закрытии строк, поэтому длительность
+// включает их чтение, а число строк известно полностью.
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	q, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	elapsed := time.Since(q.start)
+	rows := data.CommandTag.RowsAffected()
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int64("db.rows_affected", rows))
+	if data.Err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(data.Err, &pgErr) {
+			span.SetAttributes(semconv.DBResponseStatusCode(pgErr.Code))
+		}
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+	span.End()
+
+	if t.slowThreshold > 0 && elapsed >= t.slowThreshold {
+		if data.Err != nil {
+			log.Printf("SLOW QUERY: %s, %d rows, error: %v: %s", elapsed.Round(time.Microsecond), rows, data.Err, q.sql)
+		} else {
+			log.Printf("SLOW QUERY: %s, %d rows: %s", elapsed.Round(time.Microsecond), rows, q.sql)
+		}
+	}
+}
+
+// compactSQL сворачивает многострочные запросы в одну строку для лога и спанов.
+func compactSQL(sql string) string {
+	return strings.Join(strings.Fields(sql), " ")
+}
+
+// operationName — первое слово запроса (SELECT, INSERT, ...), имя спана.
+func operationName(sql string) string {
+	operation, _, _ := strings.Cut(sql, " ")
+	if operation == "" {
+		return "query"
+	}
+	return strings.ToUpper(operation)
+}
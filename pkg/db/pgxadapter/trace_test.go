@@ -0,0 +1,114 @@
+package pgxadapter_test
+
+import (
+	"avito-backend-intern-assignment/pkg/db/pgxadapter"
+	"bytes"
+	"context"
+	"log"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func newTracer(threshold time.Duration) (*pgxadapter.QueryTracer, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return pgxadapter.NewQueryTracer(pgxadapter.TracingConfig{
+		SlowQueryThreshold: threshold,
+		TracerProvider:     provider,
+	}), recorder
+}
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	out, flags := log.Writer(), log.Flags()
+	log.SetOutput(&buf)
+	log.SetFlags(0)
+	t.Cleanup(func() {
+		log.SetOutput(out)
+		log.SetFlags(flags)
+	})
+	return &buf
+}
+
+func attr(attrs []attribute.KeyValue, key string) (attribute.Value, bool) {
+	for _, a := range attrs {
+		if string(a.Key) == key {
+			return a.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestQueryTracer_RecordsSpans(t *testing.T) {
+	tracer, recorder := newTracer(0)
+	logs := captureLog(t)
+
+	ctx := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{
+		SQL: "SELECT reviewer_id\n\tFROM assigned_pr_reviewers\n\tWHERE pull_request_id = $1",
+	})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 2")})
+
+	unique := &pgconn.PgError{Code: "23505"}
+	ctx = tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "insert into pullrequests values ($1)"})
+	tracer.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: unique})
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(spans))
+	}
+
+	selectSpan := spans[0]
+	if selectSpan.Name() != "SELECT" {
+		t.Fatalf("expected span SELECT, got %q", selectSpan.Name())
+	}
+	if v, _ := attr(selectSpan.Attributes(), "db.query.text"); v.AsString() != "SELECT reviewer_id FROM assigned_pr_reviewers WHERE pull_request_id = $1" {
+		t.Fatalf("unexpected query text %q", v.AsString())
+	}
+	if v, _ := attr(selectSpan.Attributes(), "db.rows_affected"); v.AsInt64() != 2 {
+		t.Fatalf("expected 2 rows, got %v", v.AsInt64())
+	}
+	if selectSpan.Status().Code == codes.Error {
+		t.Fatal("successful query marked as error")
+	}
+
+	insertSpan := spans[1]
+	if insertSpan.Name() != "INSERT" || insertSpan.Status().Code != codes.Error {
+		t.Fatalf("expected failed INSERT span, got %q %v", insertSpan.Name(), insertSpan.Status())
+	}
+	if v, _ := attr(insertSpan.Attributes(), "db.response.status_code"); v.AsString() != "23505" {
+		t.Fatalf("expected status code 23505, got %q", v.AsString())
+	}
+
+	if logs.Len() != 0 {
+		t.Fatalf("slow query log must be disabled, got %q", logs)
+	}
+}
+
+func TestQueryTracer_LogsSlowQueries(t *testing.T) {
+	tracer, _ := newTracer(10 * time.Millisecond)
+	logs := captureLog(t)
+
+	fast := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "SELECT 1"})
+	tracer.TraceQueryEnd(fast, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("SELECT 1")})
+	if logs.Len() != 0 {
+		t.Fatalf("fast query must not be logged, got %q", logs)
+	}
+
+	slow := tracer.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: "UPDATE users SET is_active = $1"})
+	time.Sleep(15 * time.Millisecond)
+	tracer.TraceQueryEnd(slow, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("UPDATE 3")})
+
+	line := logs.String()
+	if !strings.HasPrefix(line, "SLOW QUERY: ") || !strings.Contains(line, "3 rows: UPDATE users SET is_active = $1") {
+		t.Fatalf("unexpected slow query log %q", line)
+	}
+}
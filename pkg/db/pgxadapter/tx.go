@@ -5,21 +5,23 @@ import (
 	"context"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type tx struct {
-	tx pgx.Tx
+	tx   pgx.Tx
+	pool *pgxpool.Pool
 }
 
 func (t *tx) Exec(ctx context.Context, sql string, args ...any) error {
 	_, err := t.tx.Exec(ctx, sql, args...)
-	return err
+	return translate(err)
 }
 
 func (t *tx) Query(ctx context.Context, sql string, args ...any) (db.Rows, error) {
 	pgxRows, err := t.tx.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, err
+		return nil, translate(err)
 	}
 
 	return &rows{
@@ -31,5 +33,15 @@ func (t *tx) QueryRow(ctx context.Context, sql string, args ...any) db.Row {
 	return &row{pgxRow: t.tx.QueryRow(ctx, sql, args...)}
 }
 
-func (t *tx) Commit(ctx context.Context) error   { return t.tx.Commit(ctx) }
+func (t *tx) Commit(ctx context.Context) error   { return translate(t.tx.Commit(ctx)) }
 func (t *tx) Rollback(ctx context.Context) error { return t.tx.Rollback(ctx) }
+
+// BeginTx открывает точку сохранения: Commit освобождает её, Rollback
+// откатывает изменения после неё.
+func (t *tx) BeginTx(ctx context.Context) (db.Tx, error) {
+	nested, err := t.tx.Begin(ctx)
+	if err != nil {
+		return nil, translate(err)
+	}
+	return &tx{tx: nested, pool: t.pool}, nil
+}
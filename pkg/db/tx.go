@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// IsolationLevel — уровень изоляции транзакции в терминах SQL.
+type IsolationLevel string
+
+const (
+	// IsolationDefault оставляет уровень по умолчанию для базы.
+	IsolationDefault IsolationLevel = ""
+	ReadCommitted    IsolationLevel = "read committed"
+	RepeatableRead   IsolationLevel = "repeatable read"
+	Serializable     IsolationLevel = "serializable"
+)
+
+type TxOptions struct {
+	Isolation IsolationLevel
+	ReadOnly  bool
+	// Deferrable имеет смысл только для Serializable и ReadOnly: транзакция
+	// ждёт безопасного снимка и не может быть прервана ошибкой сериализации.
+	Deferrable bool
+}
+
+// ErrTxOptionsNotSupported — хранилище не умеет начинать транзакции с
+// параметрами, отличными от умолчаний.
+var ErrTxOptionsNotSupported = errors.New("db: transaction options are not supported")
+
+// OptionsTransactional реализуют хранилища, которые умеют начинать транзакции
+// с TxOptions.
+type OptionsTransactional interface {
+	BeginTxWithOptions(ctx context.Context, opts TxOptions) (Tx, error)
+}
+
+// BeginTxWithOptions начинает транзакцию с opts. Хранилищу без поддержки
+// параметров подходят только параметры по умолчанию.
+func BeginTxWithOptions(ctx context.Context, repo Transactional, opts TxOptions) (Tx, error) {
+	if o, ok := repo.(OptionsTransactional); ok {
+		return o.BeginTxWithOptions(ctx, opts)
+	}
+	if opts != (TxOptions{}) {
+		return nil, ErrTxOptionsNotSupported
+	}
+	return repo.BeginTx(ctx)
+}
+
+// WithTxOptions — WithTx с параметрами транзакции. Во вложенной транзакции
+// параметры уже заданы внешней: допускается только изоляция не строже внешней.
+func WithTxOptions(ctx context.Context, repo Transactional, opts TxOptions, fn func(ctx context.Context, tx Tx) error) error {
+	begin := func(ctx context.Context) (Tx, error) {
+		return BeginTxWithOptions(ctx, repo, opts)
+	}
+	return runTx(ctx, opts, begin, fn)
+}
+
+// RetryPolicy задаёт, сколько раз и с какими паузами WithTxRetry повторяет
+// транзакцию.
+type RetryPolicy struct {
+	// MaxAttempts — число попыток, включая первую.
+	MaxAttempts int
+	// MinBackoff удваивается после каждой неудачной попытки, но не больше
+	// MaxBackoff; фактическая пауза выбирается случайно от половины до
+	// полного значения, чтобы конкурирующие транзакции разошлись.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy укладывается в таймаут HTTP-обработчиков.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	MinBackoff:  5 * time.Millisecond,
+	MaxBackoff:  80 * time.Millisecond,
+}
+
+// WithTxRetry выполняет fn в транзакции с opts и повторяет её целиком, если
+// она прервана ошибкой сериализации или взаимной блокировкой (ErrSerialization).
+// fn должна быть готова к повторному вызову: всё, что она накапливает вне
+// транзакции, нужно сбрасывать в начале.
+//
+// Внутри другой транзакции fn выполняется один раз в точке сохранения: после
+// ошибки сериализации повторять можно только внешнюю транзакцию целиком.
+func WithTxRetry(ctx context.Context, repo Transactional, opts TxOptions, policy RetryPolicy, fn func(ctx context.Context, tx Tx) error) error {
+	if _, ok := TxFromContext(ctx); ok {
+		return WithTxOptions(ctx, repo, opts, fn)
+	}
+
+	backoff := policy.MinBackoff
+	for attempt := 1; ; attempt++ {
+		err := WithTxOptions(ctx, repo, opts, fn)
+		if err == nil || !errors.Is(err, ErrSerialization) || attempt >= policy.MaxAttempts {
+			return err
+		}
+
+		pause := backoff
+		if pause > 1 {
+			pause = pause/2 + rand.N(pause/2+1)
+		}
+		timer := time.NewTimer(pause)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Join(err, ctx.Err())
+		case <-timer.C:
+		}
+
+		backoff = min(backoff*2, policy.MaxBackoff)
+	}
+}
@@ -0,0 +1,92 @@
+package db_test
+
+import (
+	"avito-backend-intern-assignment/pkg/db"
+	"avito-backend-intern-assignment/pkg/db/memdb"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// plainRepo умеет только BeginTx без параметров.
+type plainRepo struct {
+	db.Transactional
+}
+
+var fastRetry = db.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Microsecond, MaxBackoff: time.Millisecond}
+
+func TestWithTxRetry_RetriesSerializationFailures(t *testing.T) {
+	store := memdb.New()
+
+	var attempts int
+	err := db.WithTxRetry(context.Background(), store, db.TxOptions{Isolation: db.Serializable}, fastRetry,
+		func(context.Context, db.Tx) error {
+			attempts++
+			if attempts < 3 {
+				return fmt.Errorf("insert: %w", db.ErrSerialization)
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatalf("expected success on the last attempt, got %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestWithTxRetry_StopsOnOtherErrorsAndMaxAttempts(t *testing.T) {
+	store := memdb.New()
+	ctx := context.Background()
+
+	errBoom := errors.New("boom")
+	var attempts int
+	err := db.WithTxRetry(ctx, store, db.TxOptions{}, fastRetry, func(context.Context, db.Tx) error {
+		attempts++
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) || attempts != 1 {
+		t.Fatalf("expected a single attempt with boom, got %d attempts, %v", attempts, err)
+	}
+
+	attempts = 0
+	err = db.WithTxRetry(ctx, store, db.TxOptions{}, fastRetry, func(context.Context, db.Tx) error {
+		attempts++
+		return db.ErrSerialization
+	})
+	if !errors.Is(err, db.ErrSerialization) || attempts != fastRetry.MaxAttempts {
+		t.Fatalf("expected %d attempts ending in ErrSerialization, got %d, %v", fastRetry.MaxAttempts, attempts, err)
+	}
+}
+
+func TestWithTxRetry_StopsWhenContextIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var attempts int
+	err := db.WithTxRetry(ctx, memdb.New(), db.TxOptions{}, db.RetryPolicy{MaxAttempts: 10, MinBackoff: time.Hour, MaxBackoff: time.Hour},
+		func(context.Context, db.Tx) error {
+			attempts++
+			cancel()
+			return db.ErrSerialization
+		})
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, db.ErrSerialization) || attempts != 1 {
+		t.Fatalf("expected cancellation after one attempt, got %d attempts, %v", attempts, err)
+	}
+}
+
+func TestBeginTxWithOptions_RequiresSupport(t *testing.T) {
+	repo := plainRepo{Transactional: memdb.New()}
+	ctx := context.Background()
+
+	tx, err := db.BeginTxWithOptions(ctx, repo, db.TxOptions{})
+	if err != nil {
+		t.Fatalf("default options must fall back to BeginTx: %v", err)
+	}
+	_ = tx.Rollback(ctx)
+
+	if _, err := db.BeginTxWithOptions(ctx, repo, db.TxOptions{ReadOnly: true}); !errors.Is(err, db.ErrTxOptionsNotSupported) {
+		t.Fatalf("expected ErrTxOptionsNotSupported, got %v", err)
+	}
+}
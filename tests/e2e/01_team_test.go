@@ -30,12 +30,12 @@ func TestTeam_AddAndGet(t *testing.T) {
 	}
 
 	var created api.PostTeamAdd201JSONResponse
-	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+	if err := json.Unmarshal(rec.Body.Bytes(), &created.Body); err != nil {
 		t.Fatalf("invalid json: %v", err)
 	}
 
-	if created.Team.TeamName != body.TeamName {
-		t.Fatalf("team name mismatch: expected %s, got %s", body.TeamName, created.Team.TeamName)
+	if created.Body.Team.TeamName != body.TeamName {
+		t.Fatalf("team name mismatch: expected %s, got %s", body.TeamName, created.Body.Team.TeamName)
 	}
 
 	getReq := httptest.NewRequest(http.MethodGet, "/team/get?team_name=payments", nil)
@@ -47,12 +47,12 @@ func TestTeam_AddAndGet(t *testing.T) {
 	}
 
 	var got api.GetTeamGet200JSONResponse
-	if err := json.Unmarshal(getRec.Body.Bytes(), &got); err != nil {
+	if err := json.Unmarshal(getRec.Body.Bytes(), &got.Body); err != nil {
 		t.Fatalf("invalid json: %v", err)
 	}
 
-	if len(got.Members) != 2 {
-		t.Fatalf("expected 2 members, got %d", len(got.Members))
+	if len(got.Body.Members) != 2 {
+		t.Fatalf("expected 2 members, got %d", len(got.Body.Members))
 	}
 }
 
@@ -103,10 +103,10 @@ func TestTeam_Add_UpdateMembers(t *testing.T) {
 	}
 
 	var got api.GetTeamGet200JSONResponse
-	_ = json.Unmarshal(getRec.Body.Bytes(), &got)
+	_ = json.Unmarshal(getRec.Body.Bytes(), &got.Body)
 
-	if len(got.Members) != 2 {
-		t.Fatalf("expected 2 members after update, got %d", len(got.Members))
+	if len(got.Body.Members) != 2 {
+		t.Fatalf("expected 2 members after update, got %d", len(got.Body.Members))
 	}
 }
 
@@ -161,9 +161,9 @@ func TestTeam_Add_EmptyMembers(t *testing.T) {
 	}
 
 	var got api.GetTeamGet200JSONResponse
-	_ = json.Unmarshal(getRec.Body.Bytes(), &got)
+	_ = json.Unmarshal(getRec.Body.Bytes(), &got.Body)
 
-	if len(got.Members) != 0 {
-		t.Fatalf("expected 0 members, got %d", len(got.Members))
+	if len(got.Body.Members) != 0 {
+		t.Fatalf("expected 0 members, got %d", len(got.Body.Members))
 	}
 }
@@ -75,12 +75,12 @@ func TestPullRequest_Merge_Success(t *testing.T) {
 	}
 
 	var resp api.PostPullRequestMerge200JSONResponse
-	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp.Body); err != nil {
 		t.Fatalf("invalid json: %v", err)
 	}
 
-	if resp.Pr.Status != api.PullRequestStatusMERGED {
-		t.Fatalf("expected MERGED, got %s", resp.Pr.Status)
+	if resp.Body.Pr.Status != api.PullRequestStatusMERGED {
+		t.Fatalf("expected MERGED, got %s", resp.Body.Pr.Status)
 	}
 }
 
@@ -164,15 +164,15 @@ func TestPullRequest_Reassign_Success(t *testing.T) {
 	}
 
 	var resp api.PostPullRequestReassign200JSONResponse
-	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp.Body); err != nil {
 		t.Fatalf("invalid json: %v", err)
 	}
 
-	if resp.ReplacedBy == "" {
+	if resp.Body.ReplacedBy == "" {
 		t.Fatalf("expected replaced_by user_id, got empty")
 	}
 
-	t.Logf("Reassigned reviewer: %s -> %s", oldReviewer, resp.ReplacedBy)
+	t.Logf("Reassigned reviewer: %s -> %s", oldReviewer, resp.Body.ReplacedBy)
 }
 
 func TestPullRequest_Reassign_NotAssigned(t *testing.T) {
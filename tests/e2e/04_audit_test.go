@@ -0,0 +1,65 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAudit_ListByRequestID(t *testing.T) {
+	body := api.Team{
+		TeamName: "audit-team",
+		Members: []api.TeamMember{
+			{UserId: "audit-u1", Username: "Ann", IsActive: true},
+			{UserId: "audit-u2", Username: "Ben", IsActive: true},
+		},
+	}
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/team/add", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Actor", "lead-1")
+	req.Header.Set("X-Request-Id", "req-audit-team")
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 201 {
+		t.Fatalf("failed to create team, got %d", rec.Code)
+	}
+
+	listReq := httptest.NewRequest(http.MethodGet, "/audit/list?request_id=req-audit-team", nil)
+	listRec := httptest.NewRecorder()
+	testRouter.ServeHTTP(listRec, listReq)
+	if listRec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", listRec.Code, listRec.Body.String())
+	}
+
+	var resp api.GetAuditList200JSONResponse
+	if err := json.Unmarshal(listRec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+
+	// создание команды + два новых участника
+	if len(resp.Events) != 3 {
+		t.Fatalf("expected 3 audit events, got %d", len(resp.Events))
+	}
+	for _, e := range resp.Events {
+		if e.Actor != "lead-1" {
+			t.Fatalf("expected actor lead-1, got %s", e.Actor)
+		}
+		if e.After == nil {
+			t.Fatalf("expected after snapshot for %s %s", e.EntityType, e.EntityId)
+		}
+	}
+}
+
+func TestAudit_List_InvalidLimit(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/audit/list?limit=0", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+
+	if rec.Code != 400 {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,124 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func signGitHub(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(testGitHubSecret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func githubPullRequestPayload(action string, merged bool) []byte {
+	payload := map[string]any{
+		"action": action,
+		"pull_request": map[string]any{
+			"number": 42,
+			"title":  "Add search",
+			"merged": merged,
+			"user":   map[string]any{"login": "alice-gh"},
+		},
+		"repository": map[string]any{"full_name": "acme/api"},
+		"sender":     map[string]any{"login": "alice-gh"},
+	}
+	data, _ := json.Marshal(payload)
+	return data
+}
+
+func postGitHub(body []byte, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/vcs/github", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	req.Header.Set("X-Hub-Signature-256", signature)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestVCS_GitHub_InvalidSignature(t *testing.T) {
+	body := githubPullRequestPayload("opened", false)
+	rec := postGitHub(body, "sha256=deadbeef")
+
+	if rec.Code != 401 {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestVCS_GitLab_InvalidToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/vcs/gitlab", bytes.NewBufferString(`{"object_kind":"merge_request"}`))
+	req.Header.Set("X-Gitlab-Token", "wrong")
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+
+	if rec.Code != 401 {
+		t.Fatalf("expected 401, got %d", rec.Code)
+	}
+}
+
+func TestVCS_GitHub_OpenAndMerge(t *testing.T) {
+	link := api.PostVcsIdentitiesLinkJSONBody{Provider: "github", Login: "alice-gh", UserId: "u1"}
+	linkData, _ := json.Marshal(link)
+	linkReq := httptest.NewRequest(http.MethodPost, "/vcs/identities/link", bytes.NewBuffer(linkData))
+	linkReq.Header.Set("Content-Type", "application/json")
+	linkRec := httptest.NewRecorder()
+	testRouter.ServeHTTP(linkRec, linkReq)
+	if linkRec.Code != 200 {
+		t.Fatalf("failed to link identity, got %d", linkRec.Code)
+	}
+
+	opened := githubPullRequestPayload("opened", false)
+	rec := postGitHub(opened, signGitHub(opened))
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		PullRequestID string `json:"pull_request_id"`
+		Outcome       string `json:"outcome"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if resp.Outcome != "created" || resp.PullRequestID != "github:acme/api#42" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+
+	// повторная доставка того же события не должна падать
+	rec = postGitHub(opened, signGitHub(opened))
+	if rec.Code != 200 {
+		t.Fatalf("expected 200 on redelivery, got %d", rec.Code)
+	}
+
+	merged := githubPullRequestPayload("closed", true)
+	rec = postGitHub(merged, signGitHub(merged))
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if resp.Outcome != "merged" {
+		t.Fatalf("expected merged outcome, got %s", resp.Outcome)
+	}
+
+	// повторный merge ничего не меняет
+	rec = postGitHub(merged, signGitHub(merged))
+	if rec.Code != 200 {
+		t.Fatalf("expected 200 on redelivery, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if resp.Outcome != "ignored" {
+		t.Fatalf("expected ignored outcome on redelivery, got %s", resp.Outcome)
+	}
+}
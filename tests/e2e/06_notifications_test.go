@@ -0,0 +1,81 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNotifications_TeamSettings(t *testing.T) {
+	getReq := httptest.NewRequest(http.MethodGet, "/notifications/team/settings?team_name=payments", nil)
+	getRec := httptest.NewRecorder()
+	testRouter.ServeHTTP(getRec, getReq)
+	if getRec.Code != 200 {
+		t.Fatalf("expected 200, got %d", getRec.Code)
+	}
+
+	var defaults api.GetNotificationsTeamSettings200JSONResponse
+	json.NewDecoder(getRec.Body).Decode(&defaults)
+	if defaults.Settings.Mode != api.INSTANT {
+		t.Fatalf("expected default mode INSTANT, got %s", defaults.Settings.Mode)
+	}
+
+	chatURL := "https://chat.example.com/hooks/payments"
+	body := api.TeamNotificationSettings{TeamName: "payments", ChatWebhookUrl: &chatURL, Mode: api.DIGEST}
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/notifications/team/settings", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	getRec = httptest.NewRecorder()
+	testRouter.ServeHTTP(getRec, httptest.NewRequest(http.MethodGet, "/notifications/team/settings?team_name=payments", nil))
+	var saved api.GetNotificationsTeamSettings200JSONResponse
+	json.NewDecoder(getRec.Body).Decode(&saved)
+	if saved.Settings.Mode != api.DIGEST || saved.Settings.ChatWebhookUrl == nil || *saved.Settings.ChatWebhookUrl != chatURL {
+		t.Fatalf("settings were not saved: %+v", saved.Settings)
+	}
+}
+
+func TestNotifications_TeamSettings_InvalidURL(t *testing.T) {
+	chatURL := "not a url"
+	data, _ := json.Marshal(api.TeamNotificationSettings{TeamName: "payments", ChatWebhookUrl: &chatURL, Mode: api.INSTANT})
+	req := httptest.NewRequest(http.MethodPost, "/notifications/team/settings", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+
+	if rec.Code != 400 {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestNotifications_UserPreferences(t *testing.T) {
+	email := "bob@example.com"
+	data, _ := json.Marshal(api.UserNotificationPreferences{UserId: "u2", Email: &email, Mode: api.OFF})
+	req := httptest.NewRequest(http.MethodPost, "/notifications/user/preferences", bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var resp api.PostNotificationsUserPreferences200JSONResponse
+	json.NewDecoder(rec.Body).Decode(&resp)
+	if resp.Preferences.Mode != api.OFF || resp.Preferences.Email == nil || *resp.Preferences.Email != email {
+		t.Fatalf("unexpected preferences: %+v", resp.Preferences)
+	}
+
+	missing := httptest.NewRecorder()
+	testRouter.ServeHTTP(missing, httptest.NewRequest(http.MethodGet, "/notifications/user/preferences?user_id=nobody", nil))
+	if missing.Code != 404 {
+		t.Fatalf("expected 404 for unknown user, got %d", missing.Code)
+	}
+}
@@ -0,0 +1,96 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func postJSON(path string, body any) *httptest.ResponseRecorder {
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewBuffer(data))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestReviewSLA_SetTeamSLA(t *testing.T) {
+	rec := postJSON("/team/setReviewSla", api.PostTeamSetReviewSlaJSONBody{TeamName: "backend", ReviewSlaMinutes: 480})
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	rec = postJSON("/team/setReviewSla", api.PostTeamSetReviewSlaJSONBody{TeamName: "backend", ReviewSlaMinutes: 0})
+	if rec.Code != 400 {
+		t.Fatalf("expected 400 for zero sla, got %d", rec.Code)
+	}
+
+	rec = postJSON("/team/setReviewSla", api.PostTeamSetReviewSlaJSONBody{TeamName: "no-such-team", ReviewSlaMinutes: 60})
+	if rec.Code != 404 {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestReviewSLA_SubmitReview(t *testing.T) {
+	rec := postJSON("/pullRequest/create", api.PostPullRequestCreateJSONBody{
+		PullRequestId:   "pr-sla",
+		PullRequestName: "Tune cache",
+		AuthorId:        "u3",
+	})
+	if rec.Code != 201 {
+		t.Fatalf("expected 201, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	var created api.PostPullRequestCreate201JSONResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &created); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if created.Pr.SlaStatus == nil || *created.Pr.SlaStatus != api.OnTime {
+		t.Fatalf("expected on_time sla status, got %v", created.Pr.SlaStatus)
+	}
+	if created.Pr.Reviews == nil || len(*created.Pr.Reviews) != len(created.Pr.AssignedReviewers) {
+		t.Fatalf("expected review assignment per reviewer, got %v", created.Pr.Reviews)
+	}
+
+	rec = postJSON("/pullRequest/review", api.PostPullRequestReviewJSONBody{
+		PullRequestId: "pr-sla",
+		ReviewerId:    "u3",
+		Decision:      api.APPROVED,
+	})
+	if rec.Code != 409 {
+		t.Fatalf("expected 409 for author review, got %d", rec.Code)
+	}
+
+	reviewer := created.Pr.AssignedReviewers[0]
+	rec = postJSON("/pullRequest/review", api.PostPullRequestReviewJSONBody{
+		PullRequestId: "pr-sla",
+		ReviewerId:    reviewer,
+		Decision:      api.APPROVED,
+	})
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	var reviewed api.PostPullRequestReview200JSONResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &reviewed.Body); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	for _, r := range *reviewed.Body.Pr.Reviews {
+		if r.ReviewerId == reviewer && (r.Decision == nil || *r.Decision != api.APPROVED) {
+			t.Fatalf("expected APPROVED decision for %s, got %v", reviewer, r.Decision)
+		}
+	}
+
+	rec = postJSON("/pullRequest/review", api.PostPullRequestReviewJSONBody{
+		PullRequestId: "pr-404",
+		ReviewerId:    reviewer,
+		Decision:      api.APPROVED,
+	})
+	if rec.Code != 404 {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,130 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStats_Reviewers(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/stats/reviewers?team_name=backend", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	var resp api.GetStatsReviewers200JSONResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if !resp.From.Before(resp.To) {
+		t.Fatalf("expected default window, got %s - %s", resp.From, resp.To)
+	}
+
+	// pr-sla из 07_review_sla_test.go назначен на участников backend
+	var assignments int
+	for _, r := range resp.Reviewers {
+		if r.TeamName != "backend" {
+			t.Fatalf("unexpected team %s in filtered stats", r.TeamName)
+		}
+		assignments += r.TotalAssignments
+	}
+	if assignments == 0 {
+		t.Fatalf("expected backend assignments in window, got %+v", resp.Reviewers)
+	}
+}
+
+func TestStats_Teams(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/stats/teams", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	var resp api.GetStatsTeams200JSONResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+
+	var found bool
+	for _, team := range resp.Teams {
+		if team.TeamName == "payments" {
+			found = true
+			if team.MergedReviewed == 0 {
+				t.Fatalf("expected merged reviews for payments, got %+v", team)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("payments team missing in stats: %+v", resp.Teams)
+	}
+}
+
+func TestStats_InvalidWindow(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/stats/teams?from=2025-11-02T00:00:00Z&to=2025-11-01T00:00:00Z", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 400 {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+}
+
+func TestStats_Fairness(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/stats/fairness?team_name=backend", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	var resp api.GetStatsFairness200JSONResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if resp.Report.TeamName != "backend" || len(resp.Report.Members) == 0 {
+		t.Fatalf("unexpected report: %+v", resp.Report)
+	}
+
+	var share float64
+	for _, m := range resp.Report.Members {
+		share += m.IdealShare
+	}
+	if share < 0.99 || share > 1.01 {
+		t.Fatalf("expected ideal shares to sum to 1, got %v", share)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/stats/fairness?team_name=no-such-team", nil)
+	rec = httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 404 {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
+
+func TestStats_CycleTime(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/stats/cycleTime?group_by=author", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	var resp api.GetStatsCycleTime200JSONResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if resp.GroupBy != "author" || len(resp.Buckets) == 0 {
+		t.Fatalf("expected author buckets for merged PRs, got %+v", resp)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/stats/cycleTime?format=csv", nil)
+	rec = httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 || rec.Header().Get("Content-Type") != "text/csv" {
+		t.Fatalf("expected csv, got %d %s", rec.Code, rec.Header().Get("Content-Type"))
+	}
+}
@@ -0,0 +1,68 @@
+package e2e_test
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExport_UsersCSV(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/export/users", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	records, err := csv.NewReader(rec.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid csv: %v", err)
+	}
+	if len(records) < 3 {
+		t.Fatalf("expected header and exported users, got %d rows", len(records))
+	}
+	if strings.Join(records[0], ",") != "user_id,username,team_name,is_active" {
+		t.Fatalf("unexpected header: %v", records[0])
+	}
+}
+
+func TestExport_AssignmentsNDJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/export/assignments?format=ndjson", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Fatalf("unexpected content type %q", ct)
+	}
+
+	scanner := bufio.NewScanner(rec.Body)
+	var rows int
+	for scanner.Scan() {
+		var row map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			t.Fatalf("invalid ndjson line %q: %v", scanner.Text(), err)
+		}
+		if row["pull_request_id"] == nil || row["reviewer_id"] == nil {
+			t.Fatalf("unexpected row: %v", row)
+		}
+		rows++
+	}
+	if rows == 0 {
+		t.Fatal("expected assignment rows")
+	}
+}
+
+func TestExport_UnknownDataset(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/export/secrets", nil)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	if rec.Code != 404 {
+		t.Fatalf("expected 404, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,97 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const importCSV = `team_name,user_id,username,is_active
+platform,imp-1,Ivan,true
+platform,imp-2,Olga,true
+mobile,imp-3,Pavel,false
+`
+
+func postImport(query, contentType, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/team/import"+query, strings.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeImportPlan(t *testing.T, rec *httptest.ResponseRecorder) api.TeamImportPlan {
+	t.Helper()
+	if rec.Code != 200 {
+		t.Fatalf("expected 200, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+	var plan api.TeamImportPlan
+	if err := json.Unmarshal(rec.Body.Bytes(), &plan); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	return plan
+}
+
+func TestTeamImport_DryRunThenApply(t *testing.T) {
+	plan := decodeImportPlan(t, postImport("?dry_run=true", "text/csv", importCSV))
+	if plan.Applied || len(plan.Changes) != 5 {
+		t.Fatalf("expected 5 unapplied changes, got applied=%v changes=%d", plan.Applied, len(plan.Changes))
+	}
+
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/team/get?team_name=platform", nil))
+	if rec.Code != 404 {
+		t.Fatalf("dry run must not create team, got %d", rec.Code)
+	}
+
+	plan = decodeImportPlan(t, postImport("", "text/csv", importCSV))
+	if !plan.Applied || len(plan.Changes) != 5 {
+		t.Fatalf("expected 5 applied changes, got applied=%v changes=%d", plan.Applied, len(plan.Changes))
+	}
+
+	// повторный импорт того же файла ничего не меняет
+	plan = decodeImportPlan(t, postImport("", "text/csv", importCSV))
+	if len(plan.Changes) != 0 {
+		t.Fatalf("expected no changes on re-import, got %v", plan.Changes)
+	}
+}
+
+func TestTeamImport_MoveUserFromYAML(t *testing.T) {
+	body := `teams:
+  - team_name: mobile
+    members:
+      - { user_id: imp-2, username: Olga }
+`
+	plan := decodeImportPlan(t, postImport("", "application/yaml", body))
+	if len(plan.Changes) != 1 {
+		t.Fatalf("expected one change, got %v", plan.Changes)
+	}
+	change := plan.Changes[0]
+	if change.Kind != api.MoveUser || change.FromTeamName == nil || *change.FromTeamName != "platform" {
+		t.Fatalf("expected move from platform, got %+v", change)
+	}
+}
+
+func TestTeamImport_RejectsInvalidFile(t *testing.T) {
+	body := `team_name,user_id,username,is_active
+platform,imp-9,Anna,true
+mobile,imp-9,Anna,true
+,imp-10,Boris,true
+`
+	rec := postImport("", "text/csv", body)
+	if rec.Code != 400 {
+		t.Fatalf("expected 400, got %d", rec.Code)
+	}
+
+	var resp api.ErrorResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	if !strings.Contains(resp.Error.Message, "imp-9") || !strings.Contains(resp.Error.Message, "empty team_name") {
+		t.Fatalf("expected all problems in message, got %q", resp.Error.Message)
+	}
+
+}
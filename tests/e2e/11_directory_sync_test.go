@@ -0,0 +1,100 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim/scimtest"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func directoryRequest(t *testing.T, method, path string) api.DirectorySyncReport {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	if rec.Code != 200 {
+		t.Fatalf("%s %s: expected 200, got %d, body=%s", method, path, rec.Code, rec.Body.String())
+	}
+
+	var report api.DirectorySyncReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &report); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	return report
+}
+
+func hasChange(report api.DirectorySyncReport, kind api.TeamImportChangeKind, userID string) bool {
+	return slices.ContainsFunc(report.Changes, func(c api.TeamImportChange) bool {
+		return c.Kind == kind && c.UserId != nil && *c.UserId == userID
+	})
+}
+
+func TestDirectorySync(t *testing.T) {
+	users := []scimtest.User{
+		{ID: "1", UserName: "dir-1", DisplayName: "Dana", Active: true},
+		{ID: "2", UserName: "dir-2", DisplayName: "Egor", Active: true},
+		{ID: "3", UserName: "dir-3", DisplayName: "Fedor", Active: true},
+		{ID: "4", UserName: "imp-2", DisplayName: "Olga", Active: true},
+	}
+	scimServer.Set(users, []scimtest.Group{
+		{ID: "g1", DisplayName: "design", MemberIDs: []string{"1", "2", "3"}},
+		{ID: "g2", DisplayName: "platform", MemberIDs: []string{"4"}},
+	})
+
+	drift := directoryRequest(t, http.MethodGet, "/directory/drift")
+	if drift.Applied || drift.InSync {
+		t.Fatalf("expected unapplied drift, got applied=%v in_sync=%v", drift.Applied, drift.InSync)
+	}
+	if !hasChange(drift, api.CreateUser, "dir-1") || !hasChange(drift, api.MoveUser, "imp-2") {
+		t.Fatalf("expected create and move in drift, got %+v", drift.Changes)
+	}
+	if !slices.Contains(drift.Deactivated, "imp-1") {
+		t.Fatalf("expected imp-1 to be reported as departed, got %v", drift.Deactivated)
+	}
+
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/team/get?team_name=design", nil))
+	if rec.Code != 404 {
+		t.Fatalf("drift must not apply changes, got %d", rec.Code)
+	}
+
+	report := directoryRequest(t, http.MethodPost, "/directory/sync")
+	if !report.Applied || !slices.Contains(report.Deactivated, "imp-1") {
+		t.Fatalf("expected applied sync deactivating imp-1, got %+v", report)
+	}
+
+	rec = postJSON("/pullRequest/create", api.PostPullRequestCreateJSONBody{
+		PullRequestId:   "pr-dir",
+		PullRequestName: "Redesign onboarding",
+		AuthorId:        "dir-1",
+	})
+	if rec.Code != 201 {
+		t.Fatalf("expected 201, got %d, body=%s", rec.Code, rec.Body.String())
+	}
+
+	// dir-3 уходит из компании, на его место в группу приходит dir-4
+	scimServer.Set(append(slices.Delete(slices.Clone(users), 2, 3),
+		scimtest.User{ID: "5", UserName: "dir-4", DisplayName: "Galina", Active: true},
+	), []scimtest.Group{
+		{ID: "g1", DisplayName: "design", MemberIDs: []string{"1", "2", "5"}},
+		{ID: "g2", DisplayName: "platform", MemberIDs: []string{"4"}},
+	})
+
+	report = directoryRequest(t, http.MethodPost, "/directory/sync")
+	if !slices.Contains(report.Deactivated, "dir-3") {
+		t.Fatalf("expected dir-3 to be deactivated, got %v", report.Deactivated)
+	}
+	reassigned := slices.ContainsFunc(report.Reassigned, func(r api.DirectoryReassignment) bool {
+		return r.PullRequestId == "pr-dir" && r.OldReviewerId == "dir-3" && r.NewReviewerId != nil && *r.NewReviewerId == "dir-4"
+	})
+	if !reassigned {
+		t.Fatalf("expected pr-dir review to move from dir-3 to dir-4, got %+v", report.Reassigned)
+	}
+
+	drift = directoryRequest(t, http.MethodGet, "/directory/drift")
+	if len(drift.Changes) != 0 || len(drift.Deactivated) != 0 {
+		t.Fatalf("expected no drift after sync, got changes=%v deactivated=%v", drift.Changes, drift.Deactivated)
+	}
+}
@@ -0,0 +1,20 @@
+package e2e_test
+
+import (
+	prRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/pullrequest"
+	"avito-backend-intern-assignment/internal/app/infrastructure/repository/repotest"
+	teamRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/team"
+	userRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/user"
+	"testing"
+)
+
+func TestPostgresRepositoryContract(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repotest.Repositories {
+		return repotest.Repositories{
+			DB:           testDB,
+			Users:        userRepo.NewPostgresRepository(testDB),
+			Teams:        teamRepo.NewPostgresRepository(testDB),
+			PullRequests: prRepo.NewPostgresRepository(testDB),
+		}
+	})
+}
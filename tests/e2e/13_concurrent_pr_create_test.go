@@ -0,0 +1,73 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	prRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/pullrequest"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Повторные запросы CI на создание одного PR приходят параллельно: ровно один
+// должен создать PR с полным набором ревьюверов, остальные — получить 409.
+func TestPullRequest_Create_ConcurrentDuplicates(t *testing.T) {
+	prID := fmt.Sprintf("pr-race-%d", time.Now().UnixNano())
+	data, _ := json.Marshal(api.PostPullRequestCreateJSONBody{
+		PullRequestId:   prID,
+		PullRequestName: "Retry storm",
+		AuthorId:        "u1",
+	})
+
+	const attempts = 10
+	codes := make(chan int, attempts)
+	created := make(chan api.PostPullRequestCreate201JSONResponse, attempts)
+	start := make(chan struct{})
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", bytes.NewReader(data))
+			req.Header.Set("Content-Type", "application/json")
+			rec := httptest.NewRecorder()
+
+			<-start
+			testRouter.ServeHTTP(rec, req)
+
+			codes <- rec.Code
+			if rec.Code == http.StatusCreated {
+				var resp api.PostPullRequestCreate201JSONResponse
+				if err := json.Unmarshal(rec.Body.Bytes(), &resp); err == nil {
+					created <- resp
+				}
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(codes)
+	close(created)
+
+	counts := map[int]int{}
+	for code := range codes {
+		counts[code]++
+	}
+	if counts[http.StatusCreated] != 1 || counts[http.StatusConflict] != attempts-1 {
+		t.Fatalf("expected one 201 and %d 409, got %v", attempts-1, counts)
+	}
+
+	resp := <-created
+	stored, err := prRepo.NewPostgresRepository(testDB).GetByID(context.Background(), prID)
+	if err != nil || stored == nil {
+		t.Fatalf("get pr %s: %+v, %v", prID, stored, err)
+	}
+	if len(stored.AssignedReviewers) != len(resp.Pr.AssignedReviewers) {
+		t.Fatalf("expected reviewers %v, stored %v", resp.Pr.AssignedReviewers, stored.AssignedReviewers)
+	}
+}
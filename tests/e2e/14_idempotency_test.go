@@ -0,0 +1,78 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"avito-backend-intern-assignment/internal/app/api/middleware"
+	prRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/pullrequest"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+)
+
+func postWithKey(path, key string, body any) *httptest.ResponseRecorder {
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middleware.IdempotencyKeyHeader, key)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	return rec
+}
+
+// Ретрай вебхук-релея с тем же ключом не должен переназначать ревьювера второй раз.
+func TestIdempotency_ReassignRetry(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	prID := fmt.Sprintf("pr-idem-%d", suffix)
+
+	created := postJSON("/pullRequest/create", api.PostPullRequestCreateJSONBody{
+		PullRequestId:   prID,
+		PullRequestName: "Idempotent reassign",
+		AuthorId:        "u1",
+	})
+	if created.Code != http.StatusCreated {
+		t.Fatalf("create pr: %d %s", created.Code, created.Body)
+	}
+	var pr api.PostPullRequestCreate201JSONResponse
+	if err := json.Unmarshal(created.Body.Bytes(), &pr); err != nil || len(pr.Pr.AssignedReviewers) == 0 {
+		t.Fatalf("expected assigned reviewers, got %s (%v)", created.Body, err)
+	}
+	oldReviewer := pr.Pr.AssignedReviewers[0]
+
+	key := fmt.Sprintf("reassign-%d", suffix)
+	body := api.PostPullRequestReassignJSONBody{PullRequestId: prID, OldUserId: oldReviewer}
+	first := postWithKey("/pullRequest/reassign", key, body)
+	if first.Code != http.StatusOK {
+		t.Fatalf("reassign: %d %s", first.Code, first.Body)
+	}
+	retry := postWithKey("/pullRequest/reassign", key, body)
+	if retry.Code != http.StatusOK || retry.Body.String() != first.Body.String() {
+		t.Fatalf("expected replay of %s, got %d %s", first.Body, retry.Code, retry.Body)
+	}
+	if retry.Header().Get(middleware.IdempotentReplayedHeader) != "true" {
+		t.Fatalf("expected %s header on retry", middleware.IdempotentReplayedHeader)
+	}
+
+	var resp api.PostPullRequestReassign200JSONResponse
+	if err := json.Unmarshal(first.Body.Bytes(), &resp.Body); err != nil {
+		t.Fatalf("invalid json: %v", err)
+	}
+	stored, err := prRepo.NewPostgresRepository(testDB).GetByID(context.Background(), prID)
+	if err != nil || stored == nil {
+		t.Fatalf("get pr: %+v, %v", stored, err)
+	}
+	if slices.Contains(stored.AssignedReviewers, oldReviewer) || !slices.Contains(stored.AssignedReviewers, resp.Body.ReplacedBy) ||
+		len(stored.AssignedReviewers) != len(pr.Pr.AssignedReviewers) {
+		t.Fatalf("expected %s replaced by %s once, reviewers %v", oldReviewer, resp.Body.ReplacedBy, stored.AssignedReviewers)
+	}
+
+	other := postWithKey("/pullRequest/reassign", key, api.PostPullRequestReassignJSONBody{PullRequestId: prID, OldUserId: resp.Body.ReplacedBy})
+	if other.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("expected 422 for key reuse with another body, got %d %s", other.Code, other.Body)
+	}
+}
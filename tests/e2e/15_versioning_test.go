@@ -0,0 +1,166 @@
+package e2e_test
+
+import (
+	"avito-backend-intern-assignment/internal/app/api"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func postIfMatch(path, etag string, body any) *httptest.ResponseRecorder {
+	data, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(data))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("If-Match", etag)
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, req)
+	return rec
+}
+
+func getETag(t *testing.T, path string) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("GET %s: %d %s", path, rec.Code, rec.Body)
+	}
+	return rec.Header().Get("ETag")
+}
+
+func expectVersionMismatch(t *testing.T, rec *httptest.ResponseRecorder) {
+	t.Helper()
+	if rec.Code != http.StatusPreconditionFailed || !strings.Contains(rec.Body.String(), string(api.VERSIONMISMATCH)) {
+		t.Fatalf("expected 412 VERSION_MISMATCH, got %d %s", rec.Code, rec.Body)
+	}
+}
+
+func TestVersioning_TeamAndUser(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("versioned-%d", suffix)
+	userID := fmt.Sprintf("ver-u-%d", suffix)
+
+	added := postJSON("/team/add", api.Team{TeamName: teamName, Members: []api.TeamMember{
+		{UserId: userID, Username: "Versioned", IsActive: true},
+	}})
+	if added.Code != http.StatusCreated || added.Header().Get("ETag") != `"1"` {
+		t.Fatalf("expected 201 with ETag \"1\", got %d %q", added.Code, added.Header().Get("ETag"))
+	}
+	if etag := getETag(t, "/team/get?team_name="+teamName); etag != `"1"` {
+		t.Fatalf("expected team ETag \"1\", got %q", etag)
+	}
+
+	sla := api.PostTeamSetReviewSlaJSONBody{TeamName: teamName, ReviewSlaMinutes: 60}
+	expectVersionMismatch(t, postIfMatch("/team/setReviewSla", `"7"`, sla))
+	if rec := postIfMatch("/team/setReviewSla", `"1"`, sla); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200 for current ETag, got %d %s", rec.Code, rec.Body)
+	}
+	if etag := getETag(t, "/team/get?team_name="+teamName); etag != `"2"` {
+		t.Fatalf("expected team ETag \"2\" after SLA change, got %q", etag)
+	}
+
+	if etag := getETag(t, "/users/get?user_id="+userID); etag != `"1"` {
+		t.Fatalf("expected user ETag \"1\", got %q", etag)
+	}
+	deactivate := api.PostUsersSetIsActiveJSONBody{UserId: userID, IsActive: false}
+	expectVersionMismatch(t, postIfMatch("/users/setIsActive", `"2"`, deactivate))
+	rec := postIfMatch("/users/setIsActive", `"1"`, deactivate)
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != `"2"` {
+		t.Fatalf("expected 200 with ETag \"2\", got %d %q %s", rec.Code, rec.Header().Get("ETag"), rec.Body)
+	}
+}
+
+// Два тимлида переназначают ревьюверов одного PR, видя одну и ту же версию:
+// второй получает 412 вместо перезаписи результата первого.
+func TestVersioning_ConcurrentReassigns(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("ver-team-%d", suffix)
+	member := func(name string) api.TeamMember {
+		return api.TeamMember{UserId: fmt.Sprintf("%s-%d", name, suffix), Username: name, IsActive: true}
+	}
+	author := member("author")
+	if rec := postJSON("/team/add", api.Team{TeamName: teamName, Members: []api.TeamMember{
+		author, member("r1"), member("r2"), member("r3"), member("r4"),
+	}}); rec.Code != http.StatusCreated {
+		t.Fatalf("create team: %d %s", rec.Code, rec.Body)
+	}
+
+	prID := fmt.Sprintf("pr-ver-%d", suffix)
+	created := postJSON("/pullRequest/create", api.PostPullRequestCreateJSONBody{
+		PullRequestId:   prID,
+		PullRequestName: "Versioned",
+		AuthorId:        author.UserId,
+	})
+	var pr api.PostPullRequestCreate201JSONResponse
+	if err := json.Unmarshal(created.Body.Bytes(), &pr); err != nil || len(pr.Pr.AssignedReviewers) != 2 {
+		t.Fatalf("expected 2 reviewers, got %d %s (%v)", created.Code, created.Body, err)
+	}
+
+	etag := getETag(t, "/pullRequest/get?pull_request_id="+prID)
+	if etag != `"1"` {
+		t.Fatalf("expected PR ETag \"1\", got %q", etag)
+	}
+
+	first := postIfMatch("/pullRequest/reassign", etag,
+		api.PostPullRequestReassignJSONBody{PullRequestId: prID, OldUserId: pr.Pr.AssignedReviewers[0]})
+	if first.Code != http.StatusOK || first.Header().Get("ETag") != `"2"` {
+		t.Fatalf("expected 200 with ETag \"2\", got %d %q %s", first.Code, first.Header().Get("ETag"), first.Body)
+	}
+	expectVersionMismatch(t, postIfMatch("/pullRequest/reassign", etag,
+		api.PostPullRequestReassignJSONBody{PullRequestId: prID, OldUserId: pr.Pr.AssignedReviewers[1]}))
+
+	if rec := postIfMatch("/pullRequest/merge", "*", api.PostPullRequestMergeJSONBody{PullRequestId: prID}); rec.Code != http.StatusOK {
+		t.Fatalf("expected If-Match * to skip the check, got %d %s", rec.Code, rec.Body)
+	}
+}
+
+// Переназначение с устаревшим If-Match отклоняется и не меняет PR.
+func TestVersioning_StaleReassign(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("ver-stale-%d", suffix)
+	author := api.TeamMember{UserId: fmt.Sprintf("stale-author-%d", suffix), Username: "author", IsActive: true}
+	members := []api.TeamMember{author}
+	for _, name := range []string{"r1", "r2", "r3"} {
+		members = append(members, api.TeamMember{UserId: fmt.Sprintf("stale-%s-%d", name, suffix), Username: name, IsActive: true})
+	}
+	if rec := postJSON("/team/add", api.Team{TeamName: teamName, Members: members}); rec.Code != http.StatusCreated {
+		t.Fatalf("create team: %d %s", rec.Code, rec.Body)
+	}
+
+	prID := fmt.Sprintf("pr-stale-%d", suffix)
+	created := postJSON("/pullRequest/create", api.PostPullRequestCreateJSONBody{
+		PullRequestId:   prID,
+		PullRequestName: "Stale",
+		AuthorId:        author.UserId,
+	})
+	var pr api.PostPullRequestCreate201JSONResponse
+	if err := json.Unmarshal(created.Body.Bytes(), &pr); err != nil || len(pr.Pr.AssignedReviewers) != 2 {
+		t.Fatalf("expected 2 reviewers, got %d %s (%v)", created.Code, created.Body, err)
+	}
+
+	reassign := api.PostPullRequestReassignJSONBody{PullRequestId: prID, OldUserId: pr.Pr.AssignedReviewers[0]}
+	expectVersionMismatch(t, postIfMatch("/pullRequest/reassign", `"2"`, reassign))
+	expectVersionMismatch(t, postIfMatch("/pullRequest/reassign", `"not-a-version"`, reassign))
+
+	rec := httptest.NewRecorder()
+	testRouter.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pullRequest/get?pull_request_id="+prID, nil))
+	var got api.PullRequest
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode PR: %v (%s)", err, rec.Body)
+	}
+	if etag := rec.Header().Get("ETag"); etag != `"1"` {
+		t.Fatalf("rejected reassign must not change the version, got ETag %q", etag)
+	}
+	if fmt.Sprint(got.AssignedReviewers) != fmt.Sprint(pr.Pr.AssignedReviewers) {
+		t.Fatalf("rejected reassign must not change reviewers: %v -> %v", pr.Pr.AssignedReviewers, got.AssignedReviewers)
+	}
+
+	missing := api.PostPullRequestReassignJSONBody{PullRequestId: prID + "-missing", OldUserId: reassign.OldUserId}
+	if rec := postIfMatch("/pullRequest/reassign", `"1"`, missing); rec.Code != http.StatusNotFound {
+		t.Fatalf("expected 404 for unknown PR, got %d %s", rec.Code, rec.Body)
+	}
+}
@@ -3,15 +3,43 @@ package e2e_test
 import (
 	"avito-backend-intern-assignment/internal/app/api"
 	"avito-backend-intern-assignment/internal/app/api/handlers"
+	aHandler "avito-backend-intern-assignment/internal/app/api/handlers/audit"
+	dHandler "avito-backend-intern-assignment/internal/app/api/handlers/directory"
+	eHandler "avito-backend-intern-assignment/internal/app/api/handlers/events"
+	xHandler "avito-backend-intern-assignment/internal/app/api/handlers/export"
+	nHandler "avito-backend-intern-assignment/internal/app/api/handlers/notification"
 	prHandler "avito-backend-intern-assignment/internal/app/api/handlers/pullrequest"
+	statsHandler "avito-backend-intern-assignment/internal/app/api/handlers/stats"
 	tHandler "avito-backend-intern-assignment/internal/app/api/handlers/team"
 	uHandler "avito-backend-intern-assignment/internal/app/api/handlers/user"
+	vcsHandler "avito-backend-intern-assignment/internal/app/api/handlers/vcs"
+	wHandler "avito-backend-intern-assignment/internal/app/api/handlers/webhook"
+	"avito-backend-intern-assignment/internal/app/api/middleware"
+	"avito-backend-intern-assignment/internal/app/application/eventbus"
+	"avito-backend-intern-assignment/internal/app/application/service/audit"
+	"avito-backend-intern-assignment/internal/app/application/service/directory"
+	"avito-backend-intern-assignment/internal/app/application/service/export"
+	"avito-backend-intern-assignment/internal/app/application/service/idempotency"
+	"avito-backend-intern-assignment/internal/app/application/service/notification"
 	"avito-backend-intern-assignment/internal/app/application/service/pullrequest"
+	"avito-backend-intern-assignment/internal/app/application/service/stats"
 	"avito-backend-intern-assignment/internal/app/application/service/team"
 	"avito-backend-intern-assignment/internal/app/application/service/user"
+	"avito-backend-intern-assignment/internal/app/application/service/vcs"
+	"avito-backend-intern-assignment/internal/app/application/service/webhook"
+	auditRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/audit"
+	exportRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/export"
+	idempotencyRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/idempotency"
+	notificationRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/notification"
+	outboxRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/outbox"
 	prRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/pullrequest"
+	statsRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/stats"
 	teamRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/team"
 	userRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/user"
+	vcsRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/vcs"
+	webhookRepo "avito-backend-intern-assignment/internal/app/infrastructure/repository/webhook"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim"
+	"avito-backend-intern-assignment/internal/app/infrastructure/scim/scimtest"
 	"avito-backend-intern-assignment/internal/pkg/config"
 	"avito-backend-intern-assignment/pkg/db/pgxadapter"
 	"context"
@@ -19,14 +47,23 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+const (
+	testGitHubSecret = "github-test-secret"
+	testGitLabToken  = "gitlab-test-token"
+	testSCIMToken    = "scim-test-token"
+)
+
 var (
 	apiServer  *handlers.ApiV1
 	testRouter http.Handler
+	scimServer *scimtest.Server
+	testDB     *pgxadapter.PoolAdapter
 )
 
 func TestMain(m *testing.M) {
@@ -47,26 +84,72 @@ func TestMain(m *testing.M) {
 		log.Fatalf("failed to connect to test db: %v", err)
 	}
 	dbAdapter := &pgxadapter.PoolAdapter{Pool: pool}
+	testDB = dbAdapter
 
 	uRepo := userRepo.NewPostgresRepository(dbAdapter)
 	tRepo := teamRepo.NewPostgresRepository(dbAdapter)
 	prRepo := prRepo.NewPostgresRepository(dbAdapter)
+	aRepo := auditRepo.NewPostgresRepository(dbAdapter)
+	oRepo := outboxRepo.NewPostgresRepository(dbAdapter)
+	wRepo := webhookRepo.NewPostgresRepository(dbAdapter)
+	iRepo := vcsRepo.NewPostgresRepository(dbAdapter)
+	nRepo := notificationRepo.NewPostgresRepository(dbAdapter)
+	sRepo := statsRepo.NewPostgresRepository(dbAdapter)
+
+	bus := eventbus.New(16)
 
-	uService := user.NewService(uRepo)
-	tService := team.NewService(tRepo, uRepo, dbAdapter)
-	prService := pullrequest.NewService(prRepo, uRepo, dbAdapter)
+	uService := user.NewService(uRepo, aRepo, oRepo, dbAdapter, bus)
+	tService := team.NewService(tRepo, uRepo, aRepo, oRepo, dbAdapter, bus)
+	prService := pullrequest.NewService(prRepo, uRepo, tRepo, aRepo, oRepo, dbAdapter, bus, pullrequest.Config{
+		DefaultReviewSLA:    24 * time.Hour,
+		EscalationBatchSize: 100,
+	})
+	aService := audit.NewService(aRepo)
+	wService := webhook.NewService(wRepo, tRepo)
+	vService := vcs.NewService(iRepo, uRepo, prService)
+	nService := notification.NewService(nRepo, tRepo, uRepo)
+	sService := stats.NewService(sRepo, stats.Config{
+		DefaultWindow:     30 * 24 * time.Hour,
+		FairnessTolerance: 0.25,
+	})
 
 	prh := prHandler.NewHandler(prService)
 	uh := uHandler.NewHandler(uService)
 	th := tHandler.NewHandler(tService)
+	ah := aHandler.NewHandler(aService)
+	wh := wHandler.NewHandler(wService)
+	vh := vcsHandler.NewHandler(vService)
+	nh := nHandler.NewHandler(nService)
+	sth := statsHandler.NewHandler(sService)
+	scimServer = scimtest.NewServer(testSCIMToken)
+	dirService := directory.NewService(scim.NewClient(scim.Config{
+		BaseURL:  scimServer.URL,
+		Token:    testSCIMToken,
+		PageSize: 2,
+		Timeout:  time.Second,
+	}), uRepo, tService, uService, prService, dbAdapter, directory.Config{})
+	dh := dHandler.NewHandler(dirService)
+	vwh := vcsHandler.NewWebhookHandler(vService, testGitHubSecret, testGitLabToken)
+	sh := eHandler.NewStreamHandler(bus, time.Second)
+	xh := xHandler.NewHandler(export.NewService(exportRepo.NewPostgresRepository(dbAdapter)))
 
-	apiServer = handlers.NewApiV1(th, uh, prh)
+	apiServer = handlers.NewApiV1(th, uh, prh, ah, wh, vh, nh, sth, dh)
 
 	r := chi.NewRouter()
+	r.Use(middleware.RequestContext)
+	r.Use(middleware.Idempotency(
+		idempotency.NewService(idempotencyRepo.NewPostgresRepository(dbAdapter), idempotency.Config{TTL: time.Hour, LockTimeout: time.Minute}),
+		"POST /pullRequest/create", "POST /pullRequest/reassign", "POST /team/add", "POST /users/setIsActive",
+	))
+	r.Post("/vcs/github", vwh.GitHub)
+	r.Post("/vcs/gitlab", vwh.GitLab)
+	r.Get("/events/stream", sh.Stream)
+	r.Get("/export/{dataset}", xh.Export)
 	apiHandler := api.NewStrictHandler(apiServer, nil)
 	r.Mount("/", api.Handler(apiHandler))
 	testRouter = r
 
 	code := m.Run()
+	scimServer.Close()
 	os.Exit(code)
 }